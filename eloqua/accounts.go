@@ -1,7 +1,9 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 )
 
 // AccountService provides access to all the endpoints related
@@ -16,14 +18,14 @@ type AccountService struct {
 // Fields that are not listed in the Account model itself can be retrieved/updated
 // using the 'FieldValues' property.
 type Account struct {
-	Type          string `json:"type,omitempty"`
-	CurrentStatus string `json:"currentStatus,omitempty"`
-	ID            int    `json:"id,omitempty,string"`
-	CreatedAt     int    `json:"createdAt,omitempty,string"`
-	CreatedBy     int    `json:"createdBy,omitempty,string"`
-	Depth         string `json:"depth,omitempty"`
-	UpdatedAt     int    `json:"updatedAt,omitempty,string"`
-	UpdatedBy     int    `json:"updatedBy,omitempty,string"`
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy     int       `json:"updatedBy,omitempty,string"`
 
 	Name          string `json:"name,omitempty"`
 	Address1      string `json:"address1,omitempty"`
@@ -40,47 +42,105 @@ type Account struct {
 
 // Create a new account in eloqua
 func (e *AccountService) Create(name string, account *Account) (*Account, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, account)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *AccountService) CreateWithContext(ctx context.Context, name string, account *Account) (*Account, *Response, error) {
 	if account == nil {
 		account = &Account{}
 	}
 	account.Name = name
 	endpoint := "/data/account"
-	resp, err := e.client.postRequestDecode(endpoint, account)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, account)
 	return account, resp, err
 }
 
 // Get an account object via its ID
 func (e *AccountService) Get(id int) (*Account, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *AccountService) GetWithContext(ctx context.Context, id int) (*Account, *Response, error) {
 	endpoint := fmt.Sprintf("/data/account/%d?depth=complete", id)
 	account := &Account{}
-	resp, err := e.client.getRequestDecode(endpoint, account)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, account)
 	return account, resp, err
 }
 
 // List many Eloqua account objects
 func (e *AccountService) List(opts *ListOptions) ([]Account, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *AccountService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Account, *Response, error) {
 	endpoint := "/data/accounts"
 	accounts := new([]Account)
-	resp, err := e.client.getRequestListDecode(endpoint, accounts, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, accounts, opts)
 	return *accounts, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua account objects
+func (e *AccountService) ListPager(opts *ListOptions) *Pager[Account] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua account objects, Requesting each page in turn
+func (e *AccountService) ListAll(ctx context.Context, opts *ListOptions) ([]Account, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing account in eloqua
 func (e *AccountService) Update(id int, name string, account *Account) (*Account, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, account)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *AccountService) UpdateWithContext(ctx context.Context, id int, name string, account *Account) (*Account, *Response, error) {
 	if account == nil {
 		account = &Account{}
 	}
 	account.ID = id
 	account.Name = name
 	endpoint := fmt.Sprintf("/data/account/%d", account.ID)
-	resp, err := e.client.putRequestDecode(endpoint, account)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, account)
+	return account, resp, err
+}
+
+// UpdateChanges updates only the changed fields of an existing account in eloqua,
+// Leaving any other fields as they are. Fields set to empty values are cleared.
+// The name is required by Eloqua so is always sent along with the changes,
+// An error is returned if the changes set it to a different value.
+func (e *AccountService) UpdateChanges(id int, name string, changes *Changes) (*Account, *Response, error) {
+	return e.UpdateChangesWithContext(context.Background(), id, name, changes)
+}
+
+// UpdateChangesWithContext is like UpdateChanges but performs the request with the given context.
+func (e *AccountService) UpdateChangesWithContext(ctx context.Context, id int, name string, changes *Changes) (*Account, *Response, error) {
+	if err := changes.check(reflect.TypeOf(Account{})); err != nil {
+		return nil, nil, err
+	}
+	account := &Account{}
+	body, err := changes.bodyWith(id, "name", name)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("/data/account/%d", id)
+	resp, err := e.client.requestDecodeInto(ctx, endpoint, "PUT", body, account)
 	return account, resp, err
 }
 
 // Delete an existing account from eloqua
 func (e *AccountService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *AccountService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	account := &Account{ID: id}
 	endpoint := fmt.Sprintf("/data/account/%d", account.ID)
-	resp, err := e.client.deleteRequest(endpoint, account)
+	resp, err := e.client.deleteRequest(ctx, endpoint, account)
 	return resp, err
 }
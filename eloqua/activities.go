@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -15,7 +16,7 @@ type ActivityService struct {
 // Activity represents an Eloqua activity objects.
 type Activity struct {
 	Type         string           `json:"type,omitempty"`
-	ActivityDate int              `json:"activityDate,omitempty,string"`
+	ActivityDate Timestamp        `json:"activityDate,omitempty"`
 	ActivityType string           `json:"activityType,omitempty"`
 	Asset        int              `json:"asset,omitempty,string"`
 	AssetType    string           `json:"assetType,omitempty"`
@@ -35,9 +36,25 @@ type ActivityDetail struct {
 // Due to this being an old 1.0 endpoint this does not give the usual listing result,
 // It will only provide a simple list of activity items.
 func (e *ActivityService) List(contactID int, activtyType string, startDate int, endDate int, count int) ([]Activity, *Response, error) {
-	queryString := fmt.Sprintf("type=%s&startDate=%d&endDate=%d&count=%d", activtyType, startDate, endDate, count)
+	return e.ListWithContext(context.Background(), contactID, activtyType, startDate, endDate, count)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ActivityService) ListWithContext(ctx context.Context, contactID int, activtyType string, startDate int, endDate int, count int) ([]Activity, *Response, error) {
+	return e.ListBetweenWithContext(ctx, contactID, activtyType, Timestamp(startDate), Timestamp(endDate), count)
+}
+
+// ListBetween is like List but takes the start & end dates as Timestamps,
+// Which can be created from a time.Time with NewTimestamp.
+func (e *ActivityService) ListBetween(contactID int, activtyType string, startDate Timestamp, endDate Timestamp, count int) ([]Activity, *Response, error) {
+	return e.ListBetweenWithContext(context.Background(), contactID, activtyType, startDate, endDate, count)
+}
+
+// ListBetweenWithContext is like ListBetween but performs the request with the given context.
+func (e *ActivityService) ListBetweenWithContext(ctx context.Context, contactID int, activtyType string, startDate Timestamp, endDate Timestamp, count int) ([]Activity, *Response, error) {
+	queryString := fmt.Sprintf("type=%s&startDate=%d&endDate=%d&count=%d", activtyType, int64(startDate), int64(endDate), count)
 	endpoint := fmt.Sprintf("/api/rest/1.0/data/activities/contact/%d?%s", contactID, queryString)
 	activities := new([]Activity)
-	resp, err := e.client.getRequestDecode(endpoint, activities)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, activities)
 	return *activities, resp, err
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestActivityGet(t *testing.T) {
@@ -34,3 +35,26 @@ func TestActivityGet(t *testing.T) {
 	}}
 	testModels(t, "Activities.Get", activities[0], output)
 }
+
+func TestActivityListBetween(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addLegacyRestHandlerFunc("/data/activities/contact/1005", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "startDate", "1420070400")
+		testURLParam(t, req, "endDate", "1420156800")
+		fmt.Fprint(w, `[{"type":"Activity","activityType":"formSubmit","id":"10"}]`)
+	})
+
+	// Int variables must still be accepted by List
+	start, end := 1420070400, 1420156800
+	if _, _, err := client.Activities.List(1005, "formSubmit", start, end, 500); err != nil {
+		t.Errorf("Activities.List recieved error: %v", err)
+	}
+
+	from := NewTimestamp(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	activities, _, err := client.Activities.ListBetween(1005, "formSubmit", from, from+86400, 500)
+	if err != nil || len(activities) != 1 {
+		t.Errorf("Activities.ListBetween not as expected, Recieved: %+v %v", activities, err)
+	}
+}
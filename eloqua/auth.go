@@ -0,0 +1,37 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+)
+
+// Authenticator adds Eloqua credentials to outgoing requests.
+// A Client uses its Authenticator for every request it sends, allowing
+// alternative authentication schemes such as OAuth 2.0 to be used in place of
+// basic authentication.
+type Authenticator interface {
+	// Authenticate sets the authorization details on the given request.
+	// The context is that of the request being made and should be used for
+	// any network calls required to authenticate, such as refreshing tokens.
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// BasicAuthenticator authenticates requests using Eloqua basic authentication,
+// built from the company name, user name & password of an Eloqua user.
+type BasicAuthenticator struct {
+	header string
+}
+
+// NewBasicAuthenticator creates a BasicAuthenticator for the given Eloqua login details.
+func NewBasicAuthenticator(companyName string, userName string, password string) *BasicAuthenticator {
+	authString := companyName + "\\" + userName + ":" + password
+	encodedAuth := base64.StdEncoding.EncodeToString([]byte(authString))
+	return &BasicAuthenticator{header: "Basic " + encodedAuth}
+}
+
+// Authenticate sets the basic authorization header on the request.
+func (a *BasicAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", a.header)
+	return nil
+}
@@ -0,0 +1,41 @@
+package eloqua
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBasicAuthenticator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != client.authHeader {
+			t.Errorf("Authorization header not as expected \nExpected: %s \nReceived: %s", client.authHeader, auth)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	if _, _, err := client.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+}
+
+func TestNilAuthenticator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClientWithAuthenticator(server.URL, nil)
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Authorization header not as expected \nExpected no header \nReceived: %s", auth)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	if _, _, err := client.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// bulkBasePath is the path of the Bulk API 2.0 endpoints.
+const bulkBasePath = "/api/bulk/2.0"
+
+// BulkService provides access to the Eloqua Bulk API 2.0, used to export
+// and import large amounts of data.
+type BulkService struct {
+	client *Client
+}
+
+// BulkExport represents an Eloqua Bulk API export definition.
+// Fields maps the names of the exported columns to Eloqua Markup Language statements,
+// For example "Email": "{{Contact.Field(C_EmailAddress)}}".
+type BulkExport struct {
+	Name                     string            `json:"name,omitempty"`
+	Fields                   map[string]string `json:"fields,omitempty"`
+	Filter                   string            `json:"filter,omitempty"`
+	MaxRecords               int               `json:"maxRecords,omitempty"`
+	DataRetentionDuration    string            `json:"dataRetentionDuration,omitempty"`
+	AutoDeleteDuration       string            `json:"autoDeleteDuration,omitempty"`
+	AreSystemTimestampsInUTC bool              `json:"areSystemTimestampsInUTC,omitempty"`
+	URI                      string            `json:"uri,omitempty"`
+	CreatedBy                string            `json:"createdBy,omitempty"`
+	CreatedAt                string            `json:"createdAt,omitempty"`
+	UpdatedBy                string            `json:"updatedBy,omitempty"`
+	UpdatedAt                string            `json:"updatedAt,omitempty"`
+}
+
+// BulkSync represents an Eloqua Bulk API sync, Which performs the
+// export or import of a definition.
+type BulkSync struct {
+	SyncedInstanceURI string `json:"syncedInstanceUri,omitempty"`
+	SyncStartedAt     string `json:"syncStartedAt,omitempty"`
+	SyncEndedAt       string `json:"syncEndedAt,omitempty"`
+	Status            string `json:"status,omitempty"`
+	CallbackURL       string `json:"callbackUrl,omitempty"`
+	URI               string `json:"uri,omitempty"`
+	CreatedBy         string `json:"createdBy,omitempty"`
+	CreatedAt         string `json:"createdAt,omitempty"`
+}
+
+// Bulk sync statuses
+const (
+	BulkSyncPending = "pending"
+	BulkSyncActive  = "active"
+	BulkSyncSuccess = "success"
+	BulkSyncWarning = "warning"
+	BulkSyncError   = "error"
+)
+
+// IsComplete reports whether the sync has finished, Successfully or not.
+func (s *BulkSync) IsComplete() bool {
+	return s.Status == BulkSyncSuccess || s.Status == BulkSyncWarning || s.Status == BulkSyncError
+}
+
+// BulkSyncData is a single page of the data exported by a sync.
+type BulkSyncData struct {
+	TotalResults int               `json:"totalResults"`
+	Limit        int               `json:"limit"`
+	Offset       int               `json:"offset"`
+	Count        int               `json:"count"`
+	HasMore      bool              `json:"hasMore"`
+	Items        []json.RawMessage `json:"items"`
+}
+
+// defaultBulkPageLimit is the number of records requested per page of sync data.
+const defaultBulkPageLimit = 1000
+
+// defaultSyncPollInterval is used when waiting for a sync without a positive poll interval.
+const defaultSyncPollInterval = 5 * time.Second
+
+// bulkEndpoint returns the REST endpoint for a Bulk API uri such as "/contacts/exports/1".
+func bulkEndpoint(uri string) string {
+	return bulkBasePath + "/" + strings.Trim(uri, " /")
+}
+
+// createExport creates an export definition at the given Bulk API uri.
+func (e *BulkService) createExport(ctx context.Context, uri string, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	if export == nil {
+		export = &BulkExport{}
+	}
+	export.Name = name
+	resp, err := e.client.postRequestDecode(ctx, bulkEndpoint(uri), export)
+	return export, resp, err
+}
+
+// CreateContactExport creates a new contact export definition in eloqua
+func (e *BulkService) CreateContactExport(name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.CreateContactExportWithContext(context.Background(), name, export)
+}
+
+// CreateContactExportWithContext is like CreateContactExport but performs the request with the given context.
+func (e *BulkService) CreateContactExportWithContext(ctx context.Context, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.createExport(ctx, "/contacts/exports", name, export)
+}
+
+// CreateAccountExport creates a new account export definition in eloqua
+func (e *BulkService) CreateAccountExport(name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.CreateAccountExportWithContext(context.Background(), name, export)
+}
+
+// CreateAccountExportWithContext is like CreateAccountExport but performs the request with the given context.
+func (e *BulkService) CreateAccountExportWithContext(ctx context.Context, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.createExport(ctx, "/accounts/exports", name, export)
+}
+
+// CreateCustomObjectExport creates a new export definition for the records of the custom object with the given cdoID
+func (e *BulkService) CreateCustomObjectExport(cdoID int, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.CreateCustomObjectExportWithContext(context.Background(), cdoID, name, export)
+}
+
+// CreateCustomObjectExportWithContext is like CreateCustomObjectExport but performs the request with the given context.
+func (e *BulkService) CreateCustomObjectExportWithContext(ctx context.Context, cdoID int, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.createExport(ctx, fmt.Sprintf("/customObjects/%d/exports", cdoID), name, export)
+}
+
+// CreateActivityExport creates a new activity export definition in eloqua.
+// Activity exports must filter on a single activity type.
+func (e *BulkService) CreateActivityExport(name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.CreateActivityExportWithContext(context.Background(), name, export)
+}
+
+// CreateActivityExportWithContext is like CreateActivityExport but performs the request with the given context.
+func (e *BulkService) CreateActivityExportWithContext(ctx context.Context, name string, export *BulkExport) (*BulkExport, *Response, error) {
+	return e.createExport(ctx, "/activities/exports", name, export)
+}
+
+// GetExport gets an export definition via its uri, Such as "/contacts/exports/1"
+func (e *BulkService) GetExport(uri string) (*BulkExport, *Response, error) {
+	return e.GetExportWithContext(context.Background(), uri)
+}
+
+// GetExportWithContext is like GetExport but performs the request with the given context.
+func (e *BulkService) GetExportWithContext(ctx context.Context, uri string) (*BulkExport, *Response, error) {
+	export := &BulkExport{}
+	resp, err := e.client.getRequestDecode(ctx, bulkEndpoint(uri), export)
+	return export, resp, err
+}
+
+// DeleteExport deletes an export definition via its uri
+func (e *BulkService) DeleteExport(uri string) (*Response, error) {
+	return e.DeleteExportWithContext(context.Background(), uri)
+}
+
+// DeleteExportWithContext is like DeleteExport but performs the request with the given context.
+func (e *BulkService) DeleteExportWithContext(ctx context.Context, uri string) (*Response, error) {
+	return e.client.deleteRequest(ctx, bulkEndpoint(uri), nil)
+}
+
+// CreateSync starts a sync of the export or import definition with the given uri
+func (e *BulkService) CreateSync(syncedInstanceURI string, sync *BulkSync) (*BulkSync, *Response, error) {
+	return e.CreateSyncWithContext(context.Background(), syncedInstanceURI, sync)
+}
+
+// CreateSyncWithContext is like CreateSync but performs the request with the given context.
+func (e *BulkService) CreateSyncWithContext(ctx context.Context, syncedInstanceURI string, sync *BulkSync) (*BulkSync, *Response, error) {
+	if sync == nil {
+		sync = &BulkSync{}
+	}
+	sync.SyncedInstanceURI = syncedInstanceURI
+	resp, err := e.client.postRequestDecode(ctx, bulkEndpoint("/syncs"), sync)
+	return sync, resp, err
+}
+
+// GetSync gets a sync via its uri, Such as "/syncs/5", to check its status
+func (e *BulkService) GetSync(uri string) (*BulkSync, *Response, error) {
+	return e.GetSyncWithContext(context.Background(), uri)
+}
+
+// GetSyncWithContext is like GetSync but performs the request with the given context.
+func (e *BulkService) GetSyncWithContext(ctx context.Context, uri string) (*BulkSync, *Response, error) {
+	sync := &BulkSync{}
+	resp, err := e.client.getRequestDecode(ctx, bulkEndpoint(uri), sync)
+	return sync, resp, err
+}
+
+// WaitForSync polls the sync with the given uri at the given interval until it has completed,
+// Polling every 5 seconds if the interval is not positive.
+// The completed sync is returned, Its status should be checked for errors or warnings.
+func (e *BulkService) WaitForSync(uri string, pollInterval time.Duration) (*BulkSync, *Response, error) {
+	return e.WaitForSyncWithContext(context.Background(), uri, pollInterval)
+}
+
+// WaitForSyncWithContext is like WaitForSync but performs the requests with the given context.
+// Polling stops when the context is cancelled or its deadline passes.
+func (e *BulkService) WaitForSyncWithContext(ctx context.Context, uri string, pollInterval time.Duration) (*BulkSync, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultSyncPollInterval
+	}
+
+	for {
+		sync, resp, err := e.GetSyncWithContext(ctx, uri)
+		if err != nil || sync.IsComplete() {
+			return sync, resp, err
+		}
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return sync, resp, err
+		}
+	}
+}
+
+// GetSyncData gets a page of data exported by the sync with the given uri,
+// Starting at offset and containing at most limit records.
+func (e *BulkService) GetSyncData(uri string, offset int, limit int) (*BulkSyncData, *Response, error) {
+	return e.GetSyncDataWithContext(context.Background(), uri, offset, limit)
+}
+
+// GetSyncDataWithContext is like GetSyncData but performs the request with the given context.
+func (e *BulkService) GetSyncDataWithContext(ctx context.Context, uri string, offset int, limit int) (*BulkSyncData, *Response, error) {
+	if limit <= 0 {
+		limit = defaultBulkPageLimit
+	}
+	endpoint := fmt.Sprintf("%s/data?offset=%d&limit=%d", bulkEndpoint(uri), offset, limit)
+	data := &BulkSyncData{}
+	resp, err := e.client.getRequestDecode(ctx, endpoint, data)
+	return data, resp, err
+}
+
+// SyncData returns an iterator over every record exported by the sync with the given uri,
+// Requesting pages of limit records in turn. Each record maps the export's field names to values.
+func (e *BulkService) SyncData(ctx context.Context, uri string, limit int) iter.Seq2[map[string]string, error] {
+	return BulkSyncRows[map[string]string](ctx, e, uri, limit)
+}
+
+// BulkSyncRows returns an iterator over every record exported by the sync with the given uri,
+// Decoding each record into a T. T is usually a struct with json tags matching the export's field names.
+// Iteration stops after the first error, Which is yielded with a zero-valued record.
+func BulkSyncRows[T any](ctx context.Context, bulk *BulkService, uri string, limit int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		offset := 0
+		for {
+			data, _, err := bulk.GetSyncDataWithContext(ctx, uri, offset, limit)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range data.Items {
+				var row T
+				if err := json.Unmarshal(item, &row); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			offset += len(data.Items)
+			if !data.HasMore || len(data.Items) == 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Bulk activity types, Used to filter activity exports.
+// Each activity export must filter on a single activity type.
+const (
+	BulkActivityEmailSend         = "EmailSend"
+	BulkActivityEmailOpen         = "EmailOpen"
+	BulkActivityEmailClickthrough = "EmailClickthrough"
+	BulkActivitySubscribe         = "Subscribe"
+	BulkActivityUnsubscribe       = "Unsubscribe"
+	BulkActivityBounceback        = "Bounceback"
+	BulkActivityFormSubmit        = "FormSubmit"
+	BulkActivityPageView          = "PageView"
+	BulkActivityWebVisit          = "WebVisit"
+)
+
+// BulkActivity contains the fields exported for every activity type.
+type BulkActivity struct {
+	ActivityID   string `json:"ActivityId"`
+	ActivityType string `json:"ActivityType"`
+	ActivityDate string `json:"ActivityDate"`
+	ContactID    string `json:"ContactId"`
+}
+
+// BulkEmailActivity contains the fields exported for every email activity type.
+type BulkEmailActivity struct {
+	BulkActivity
+	EmailAddress     string `json:"EmailAddress"`
+	AssetID          string `json:"AssetId"`
+	AssetName        string `json:"AssetName"`
+	AssetType        string `json:"AssetType"`
+	CampaignID       string `json:"CampaignId"`
+	EmailRecipientID string `json:"EmailRecipientId"`
+	DeploymentID     string `json:"DeploymentId"`
+	SubjectLine      string `json:"SubjectLine"`
+	EmailWebLink     string `json:"EmailWebLink"`
+}
+
+// BulkEmailSendActivity is a row of an EmailSend activity export.
+type BulkEmailSendActivity struct {
+	BulkEmailActivity
+}
+
+// BulkEmailOpenActivity is a row of an EmailOpen activity export.
+type BulkEmailOpenActivity struct {
+	BulkEmailActivity
+	IPAddress string `json:"IpAddress"`
+	VisitorID string `json:"VisitorId"`
+}
+
+// BulkEmailClickthroughActivity is a row of an EmailClickthrough activity export.
+type BulkEmailClickthroughActivity struct {
+	BulkEmailActivity
+	IPAddress            string `json:"IpAddress"`
+	VisitorID            string `json:"VisitorId"`
+	EmailClickedThruLink string `json:"EmailClickedThruLink"`
+}
+
+// BulkSubscriptionActivity is a row of a Subscribe or Unsubscribe activity export.
+// The asset is the email group subscribed to or unsubscribed from.
+type BulkSubscriptionActivity struct {
+	BulkActivity
+	EmailAddress     string `json:"EmailAddress"`
+	AssetID          string `json:"AssetId"`
+	AssetName        string `json:"AssetName"`
+	AssetType        string `json:"AssetType"`
+	CampaignID       string `json:"CampaignId"`
+	EmailRecipientID string `json:"EmailRecipientId"`
+}
+
+// BulkBouncebackActivity is a row of a Bounceback activity export.
+type BulkBouncebackActivity struct {
+	BulkActivity
+	EmailAddress     string `json:"EmailAddress"`
+	AssetID          string `json:"AssetId"`
+	AssetName        string `json:"AssetName"`
+	AssetType        string `json:"AssetType"`
+	CampaignID       string `json:"CampaignId"`
+	EmailRecipientID string `json:"EmailRecipientId"`
+	DeploymentID     string `json:"DeploymentId"`
+	SMTPErrorCode    string `json:"SmtpErrorCode"`
+	SMTPStatusCode   string `json:"SmtpStatusCode"`
+	SMTPMessage      string `json:"SmtpMessage"`
+}
+
+// BulkFormSubmitActivity is a row of a FormSubmit activity export.
+// RawData contains the submitted form values as a query string.
+type BulkFormSubmitActivity struct {
+	BulkActivity
+	AssetID    string `json:"AssetId"`
+	AssetName  string `json:"AssetName"`
+	AssetType  string `json:"AssetType"`
+	CampaignID string `json:"CampaignId"`
+	VisitorID  string `json:"VisitorId"`
+	RawData    string `json:"RawData"`
+}
+
+// BulkPageViewActivity is a row of a PageView activity export.
+type BulkPageViewActivity struct {
+	BulkActivity
+	CampaignID           string `json:"CampaignId"`
+	VisitorID            string `json:"VisitorId"`
+	VisitorExternalID    string `json:"VisitorExternalId"`
+	WebVisitID           string `json:"WebVisitId"`
+	URL                  string `json:"Url"`
+	ReferrerURL          string `json:"ReferrerUrl"`
+	IPAddress            string `json:"IpAddress"`
+	IsWebTrackingOptedIn string `json:"IsWebTrackingOptedIn"`
+}
+
+// BulkWebVisitActivity is a row of a WebVisit activity export.
+type BulkWebVisitActivity struct {
+	BulkActivity
+	VisitorID         string `json:"VisitorId"`
+	VisitorExternalID string `json:"VisitorExternalId"`
+	ReferrerURL       string `json:"ReferrerUrl"`
+	IPAddress         string `json:"IpAddress"`
+	NumberOfPages     string `json:"NumberOfPages"`
+	FirstPageViewURL  string `json:"FirstPageViewUrl"`
+	Duration          string `json:"Duration"`
+	QueryString       string `json:"QueryString"`
+}
+
+// Field statements shared between activity types
+var (
+	bulkActivityBaseFields = map[string]string{
+		"ActivityId":   "{{Activity.Id}}",
+		"ActivityType": "{{Activity.Type}}",
+		"ActivityDate": "{{Activity.CreatedAt}}",
+		"ContactId":    "{{Activity.Contact.Id}}",
+	}
+	bulkActivityAssetFields = map[string]string{
+		"AssetId":    "{{Activity.Asset.Id}}",
+		"AssetName":  "{{Activity.Asset.Name}}",
+		"AssetType":  "{{Activity.Asset.Type}}",
+		"CampaignId": "{{Activity.Campaign.Id}}",
+	}
+	bulkActivityRecipientFields = map[string]string{
+		"EmailAddress":     "{{Activity.Field(EmailAddress)}}",
+		"EmailRecipientId": "{{Activity.Field(EmailRecipientId)}}",
+	}
+	bulkActivityEmailFields = map[string]string{
+		"DeploymentId": "{{Activity.Field(EmailDeploymentId)}}",
+		"SubjectLine":  "{{Activity.Field(SubjectLine)}}",
+		"EmailWebLink": "{{Activity.Field(EmailWebLink)}}",
+	}
+	bulkActivityVisitorFields = map[string]string{
+		"VisitorId": "{{Activity.Visitor.Id}}",
+		"IpAddress": "{{Activity.Field(IpAddress)}}",
+	}
+)
+
+// bulkActivityFields are the field statements exported for each activity type,
+// Matching the json tags of the activity type's row struct.
+var bulkActivityFields = map[string][]map[string]string{
+	BulkActivityEmailSend: {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields, bulkActivityEmailFields},
+	BulkActivityEmailOpen: {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields, bulkActivityEmailFields, bulkActivityVisitorFields},
+	BulkActivityEmailClickthrough: {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields, bulkActivityEmailFields, bulkActivityVisitorFields, {
+		"EmailClickedThruLink": "{{Activity.Field(EmailClickedThruLink)}}",
+	}},
+	BulkActivitySubscribe:   {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields},
+	BulkActivityUnsubscribe: {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields},
+	BulkActivityBounceback: {bulkActivityBaseFields, bulkActivityAssetFields, bulkActivityRecipientFields, {
+		"DeploymentId":   "{{Activity.Field(EmailDeploymentId)}}",
+		"SmtpErrorCode":  "{{Activity.Field(SmtpErrorCode)}}",
+		"SmtpStatusCode": "{{Activity.Field(SmtpStatusCode)}}",
+		"SmtpMessage":    "{{Activity.Field(SmtpMessage)}}",
+	}},
+	BulkActivityFormSubmit: {bulkActivityBaseFields, bulkActivityAssetFields, {
+		"VisitorId": "{{Activity.Visitor.Id}}",
+		"RawData":   "{{Activity.Field(RawData)}}",
+	}},
+	BulkActivityPageView: {bulkActivityBaseFields, bulkActivityVisitorFields, {
+		"CampaignId":           "{{Activity.Campaign.Id}}",
+		"VisitorExternalId":    "{{Activity.Visitor.ExternalId}}",
+		"WebVisitId":           "{{Activity.Field(WebVisitId)}}",
+		"Url":                  "{{Activity.Field(Url)}}",
+		"ReferrerUrl":          "{{Activity.Field(ReferrerUrl)}}",
+		"IsWebTrackingOptedIn": "{{Activity.Field(IsWebTrackingOptedIn)}}",
+	}},
+	BulkActivityWebVisit: {bulkActivityBaseFields, bulkActivityVisitorFields, {
+		"VisitorExternalId": "{{Activity.Visitor.ExternalId}}",
+		"ReferrerUrl":       "{{Activity.Field(ReferrerUrl)}}",
+		"NumberOfPages":     "{{Activity.Field(NumberOfPages)}}",
+		"FirstPageViewUrl":  "{{Activity.Field(FirstPageViewUrl)}}",
+		"Duration":          "{{Activity.Field(Duration)}}",
+		"QueryString":       "{{Activity.Field(QueryString)}}",
+	}},
+}
+
+// BulkActivityFields returns the field statements exported for the given activity type,
+// Keyed to match the json tags of the type's row struct such as BulkEmailOpenActivity.
+// Nil is returned for unknown activity types.
+func BulkActivityFields(activityType string) map[string]string {
+	groups, ok := bulkActivityFields[activityType]
+	if !ok {
+		return nil
+	}
+
+	fields := map[string]string{}
+	for _, group := range groups {
+		for name, statement := range group {
+			fields[name] = statement
+		}
+	}
+	return fields
+}
+
+// BulkActivityFilter builds an export filter for activities of the given type that occurred
+// at or after from and before to. A zero from or to leaves that end of the range open.
+func BulkActivityFilter(activityType string, from time.Time, to time.Time) string {
+	filters := []EMLFilter{EMLActivityType.Equal(activityType)}
+	if !from.IsZero() {
+		filters = append(filters, EMLActivityDate.GreaterThanOrEqual(FormatBulkTime(from)))
+	}
+	if !to.IsZero() {
+		filters = append(filters, EMLActivityDate.LessThan(FormatBulkTime(to)))
+	}
+	return EMLAnd(filters...).String()
+}
+
+// NewBulkActivityExport creates an export definition for activities of the given type
+// between from and to, Exporting the fields of the type's row struct.
+// The definition must then be created with BulkService.CreateActivityExport.
+func NewBulkActivityExport(activityType string, from time.Time, to time.Time) *BulkExport {
+	return &BulkExport{
+		Fields: BulkActivityFields(activityType),
+		Filter: BulkActivityFilter(activityType, from, to),
+	}
+}
+
+// ExportActivities creates and syncs an export of activities of the given type between from and to,
+// Then returns an iterator over the exported rows decoded into a T, Such as BulkEmailOpenActivity.
+// The sync is polled at the given interval until it completes.
+// Iteration stops after the first error, Which is yielded with a zero-valued row.
+func ExportActivities[T any](ctx context.Context, bulk *BulkService, activityType string, from time.Time, to time.Time, pollInterval time.Duration) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if _, ok := bulkActivityFields[activityType]; !ok {
+			yield(zero, fmt.Errorf("Unknown bulk activity type %q", activityType))
+			return
+		}
+
+		name := fmt.Sprintf("%s Activity Export", activityType)
+		export, _, err := bulk.CreateActivityExportWithContext(ctx, name, NewBulkActivityExport(activityType, from, to))
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		sync, _, err := bulk.CreateSyncWithContext(ctx, export.URI, nil)
+		if err == nil {
+			sync, _, err = bulk.WaitForSyncWithContext(ctx, sync.URI, pollInterval)
+		}
+		if err == nil && sync.Status == BulkSyncError {
+			err = fmt.Errorf("Bulk activity export sync %s failed", sync.URI)
+		}
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		for row, err := range BulkSyncRows[T](ctx, bulk, sync.URI, 0) {
+			if !yield(row, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestBulkActivityFieldsMatchRows checks the exported fields of each activity type
+// match the json tags of its row struct, So that every exported value is decoded.
+func TestBulkActivityFieldsMatchRows(t *testing.T) {
+	rows := map[string]interface{}{
+		BulkActivityEmailSend:         BulkEmailSendActivity{},
+		BulkActivityEmailOpen:         BulkEmailOpenActivity{},
+		BulkActivityEmailClickthrough: BulkEmailClickthroughActivity{},
+		BulkActivitySubscribe:         BulkSubscriptionActivity{},
+		BulkActivityUnsubscribe:       BulkSubscriptionActivity{},
+		BulkActivityBounceback:        BulkBouncebackActivity{},
+		BulkActivityFormSubmit:        BulkFormSubmitActivity{},
+		BulkActivityPageView:          BulkPageViewActivity{},
+		BulkActivityWebVisit:          BulkWebVisitActivity{},
+	}
+
+	for activityType, row := range rows {
+		fields := BulkActivityFields(activityType)
+		if fields == nil {
+			t.Errorf("No fields for activity type %s", activityType)
+			continue
+		}
+
+		content, _ := json.Marshal(row)
+		tags := map[string]interface{}{}
+		json.Unmarshal(content, &tags)
+
+		for name := range fields {
+			if _, ok := tags[name]; !ok {
+				t.Errorf("%s field %s not in row struct %T", activityType, name, row)
+			}
+		}
+		for name := range tags {
+			if _, ok := fields[name]; !ok {
+				t.Errorf("%s row struct %T field %s is not exported", activityType, row, name)
+			}
+		}
+	}
+
+	if BulkActivityFields("Unknown") != nil {
+		t.Error("Expected no fields for an unknown activity type")
+	}
+}
+
+func TestBulkActivityFilter(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	tests := []struct {
+		from, to time.Time
+		want     string
+	}{
+		{from, to, "('{{Activity.Type}}' = 'EmailOpen') AND ('{{Activity.CreatedAt}}' >= '2024-01-01T00:00:00Z') AND ('{{Activity.CreatedAt}}' < '2024-01-02T00:00:00Z')"},
+		{from, time.Time{}, "('{{Activity.Type}}' = 'EmailOpen') AND ('{{Activity.CreatedAt}}' >= '2024-01-01T00:00:00Z')"},
+		{time.Time{}, time.Time{}, "'{{Activity.Type}}' = 'EmailOpen'"},
+		{from.In(time.FixedZone("EST", -5*60*60)), time.Time{}, "('{{Activity.Type}}' = 'EmailOpen') AND ('{{Activity.CreatedAt}}' >= '2024-01-01T00:00:00Z')"},
+	}
+
+	for _, test := range tests {
+		if filter := BulkActivityFilter(BulkActivityEmailOpen, test.from, test.to); filter != test.want {
+			t.Errorf("BulkActivityFilter not as expected, Expected %s, Recieved %s", test.want, filter)
+		}
+	}
+}
+
+func TestExportActivities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	addBulkHandlerFunc("/activities/exports", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(BulkExport)
+		json.NewDecoder(req.Body).Decode(v)
+		want := NewBulkActivityExport(BulkActivityEmailClickthrough, from, to)
+		want.Name = "EmailClickthrough Activity Export"
+		testModels(t, "ExportActivities export body", v, want)
+		fmt.Fprint(w, `{"uri":"/activities/exports/5"}`)
+	})
+	addBulkHandlerFunc("/syncs", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"syncedInstanceUri":"/activities/exports/5","status":"pending","uri":"/syncs/6"}`)
+	})
+	addBulkHandlerFunc("/syncs/6", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"success","uri":"/syncs/6"}`)
+	})
+	addBulkHandlerFunc("/syncs/6/data", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"totalResults":1,"hasMore":false,"items":[{"ActivityId":"11","ActivityType":"EmailClickthrough","ActivityDate":"2024-01-01 09:30:00.000","ContactId":"7","EmailAddress":"test@example.com","AssetId":"3","EmailClickedThruLink":"https://example.com"}]}`)
+	})
+
+	rows := []BulkEmailClickthroughActivity{}
+	for row, err := range ExportActivities[BulkEmailClickthroughActivity](context.Background(), client.Bulk, BulkActivityEmailClickthrough, from, to, time.Millisecond) {
+		if err != nil {
+			t.Fatalf("ExportActivities recieved error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+
+	want := BulkEmailClickthroughActivity{EmailClickedThruLink: "https://example.com"}
+	want.ActivityID = "11"
+	want.ActivityType = "EmailClickthrough"
+	want.ActivityDate = "2024-01-01 09:30:00.000"
+	want.ContactID = "7"
+	want.EmailAddress = "test@example.com"
+	want.AssetID = "3"
+	if len(rows) != 1 || !reflect.DeepEqual(rows[0], want) {
+		t.Errorf("ExportActivities rows not as expected, Recieved: %+v", rows)
+	}
+}
+
+func TestExportActivitiesErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, err := range ExportActivities[BulkActivity](context.Background(), client.Bulk, "Unknown", time.Time{}, time.Time{}, time.Millisecond) {
+		if err == nil {
+			t.Error("Expected an error for an unknown activity type")
+		}
+	}
+
+	addBulkHandlerFunc("/activities/exports", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"uri":"/activities/exports/5"}`)
+	})
+	addBulkHandlerFunc("/syncs", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"pending","uri":"/syncs/6"}`)
+	})
+	addBulkHandlerFunc("/syncs/6", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"error","uri":"/syncs/6"}`)
+	})
+
+	errs := 0
+	for _, err := range ExportActivities[BulkPageViewActivity](context.Background(), client.Bulk, BulkActivityPageView, time.Time{}, time.Time{}, time.Millisecond) {
+		if err == nil || err.Error() != "Bulk activity export sync /syncs/6 failed" {
+			t.Errorf("Expected an error for a failed sync, Recieved: %v", err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Expected a single error to be yielded, Recieved %d", errs)
+	}
+}
@@ -0,0 +1,127 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+)
+
+// BulkField represents a field available to Bulk API definitions.
+// Statement is the Eloqua Markup Language statement used to reference the field,
+// For example "{{Contact.Field(C_EmailAddress)}}".
+type BulkField struct {
+	Name                    string `json:"name,omitempty"`
+	InternalName            string `json:"internalName,omitempty"`
+	DataType                string `json:"dataType,omitempty"`
+	DefaultValue            string `json:"defaultValue,omitempty"`
+	HasReadOnlyConstraint   bool   `json:"hasReadOnlyConstraint,omitempty"`
+	HasNotNullConstraint    bool   `json:"hasNotNullConstraint,omitempty"`
+	HasUniquenessConstraint bool   `json:"hasUniquenessConstraint,omitempty"`
+	Statement               string `json:"statement,omitempty"`
+	URI                     string `json:"uri,omitempty"`
+	CreatedAt               string `json:"createdAt,omitempty"`
+	UpdatedAt               string `json:"updatedAt,omitempty"`
+}
+
+// EML returns the field's statement for use in definitions and filters.
+func (f BulkField) EML() EMLStatement {
+	return EMLStatement(f.Statement)
+}
+
+// bulkFieldPage is a single page of Bulk API fields.
+type bulkFieldPage struct {
+	TotalResults int         `json:"totalResults"`
+	Limit        int         `json:"limit"`
+	Offset       int         `json:"offset"`
+	Count        int         `json:"count"`
+	HasMore      bool        `json:"hasMore"`
+	Items        []BulkField `json:"items"`
+}
+
+// listFields requests every page of fields at the given Bulk API uri.
+func (e *BulkService) listFields(ctx context.Context, uri string) ([]BulkField, *Response, error) {
+	fields := []BulkField{}
+	offset := 0
+	for {
+		endpoint := fmt.Sprintf("%s?offset=%d&limit=%d", bulkEndpoint(uri), offset, defaultBulkPageLimit)
+		page := &bulkFieldPage{}
+		resp, err := e.client.getRequestDecode(ctx, endpoint, page)
+		if err != nil {
+			return fields, resp, err
+		}
+
+		fields = append(fields, page.Items...)
+		offset += len(page.Items)
+		if !page.HasMore || len(page.Items) == 0 {
+			return fields, resp, nil
+		}
+	}
+}
+
+// ContactFields lists every contact field available to Bulk API definitions
+func (e *BulkService) ContactFields() ([]BulkField, *Response, error) {
+	return e.ContactFieldsWithContext(context.Background())
+}
+
+// ContactFieldsWithContext is like ContactFields but performs the requests with the given context.
+func (e *BulkService) ContactFieldsWithContext(ctx context.Context) ([]BulkField, *Response, error) {
+	return e.listFields(ctx, "/contacts/fields")
+}
+
+// AccountFields lists every account field available to Bulk API definitions
+func (e *BulkService) AccountFields() ([]BulkField, *Response, error) {
+	return e.AccountFieldsWithContext(context.Background())
+}
+
+// AccountFieldsWithContext is like AccountFields but performs the requests with the given context.
+func (e *BulkService) AccountFieldsWithContext(ctx context.Context) ([]BulkField, *Response, error) {
+	return e.listFields(ctx, "/accounts/fields")
+}
+
+// CustomObjectFields lists every field of the custom object with the given cdoID available to Bulk API definitions
+func (e *BulkService) CustomObjectFields(cdoID int) ([]BulkField, *Response, error) {
+	return e.CustomObjectFieldsWithContext(context.Background(), cdoID)
+}
+
+// CustomObjectFieldsWithContext is like CustomObjectFields but performs the requests with the given context.
+func (e *BulkService) CustomObjectFieldsWithContext(ctx context.Context, cdoID int) ([]BulkField, *Response, error) {
+	return e.listFields(ctx, fmt.Sprintf("/customObjects/%d/fields", cdoID))
+}
+
+// ActivityFields lists every activity field available to Bulk API definitions
+func (e *BulkService) ActivityFields() ([]BulkField, *Response, error) {
+	return e.ActivityFieldsWithContext(context.Background())
+}
+
+// ActivityFieldsWithContext is like ActivityFields but performs the requests with the given context.
+func (e *BulkService) ActivityFieldsWithContext(ctx context.Context) ([]BulkField, *Response, error) {
+	return e.listFields(ctx, "/activities/fields")
+}
+
+// FindBulkField returns the field with the given internal name, Or name, from fields.
+func FindBulkField(fields []BulkField, name string) (BulkField, bool) {
+	for _, field := range fields {
+		if field.InternalName == name {
+			return field, true
+		}
+	}
+	for _, field := range fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return BulkField{}, false
+}
+
+// BulkFieldStatements builds the Fields map of an export or import definition from the given fields,
+// Keyed by each field's internal name.
+func BulkFieldStatements(fields ...BulkField) map[string]string {
+	statements := make(map[string]string, len(fields))
+	for _, field := range fields {
+		key := field.InternalName
+		if key == "" {
+			key = field.Name
+		}
+		statements[key] = field.Statement
+	}
+	return statements
+}
@@ -0,0 +1,74 @@
+package eloqua
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBulkContactFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/contacts/fields", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		testURLParam(t, req, "limit", "1000")
+		if req.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"totalResults":2,"limit":1000,"offset":0,"count":1,"hasMore":true,"items":[{"name":"Email Address","internalName":"C_EmailAddress","dataType":"emailAddress","hasUniquenessConstraint":true,"statement":"{{Contact.Field(C_EmailAddress)}}","uri":"/contacts/fields/100001"}]}`)
+		} else {
+			testURLParam(t, req, "offset", "1")
+			fmt.Fprint(w, `{"totalResults":2,"limit":1000,"offset":1,"count":1,"hasMore":false,"items":[{"name":"Country","internalName":"C_Country","dataType":"string","statement":"{{Contact.Field(C_Country)}}","uri":"/contacts/fields/100002"}]}`)
+		}
+	})
+
+	fields, _, err := client.Bulk.ContactFields()
+	if err != nil {
+		t.Fatalf("Bulk.ContactFields recieved error: %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Bulk.ContactFields expected 2 fields, Recieved %d", len(fields))
+	}
+
+	want := BulkField{Name: "Email Address", InternalName: "C_EmailAddress", DataType: "emailAddress", HasUniquenessConstraint: true, Statement: "{{Contact.Field(C_EmailAddress)}}", URI: "/contacts/fields/100001"}
+	testModels(t, "Bulk.ContactFields", fields[0], want)
+
+	field, ok := FindBulkField(fields, "Country")
+	if !ok || field.EML() != ContactFieldStatement("C_Country") {
+		t.Errorf("FindBulkField by name not as expected, Recieved: %+v", field)
+	}
+	if _, ok := FindBulkField(fields, "C_Missing"); ok {
+		t.Error("FindBulkField should not find a missing field")
+	}
+
+	statements := BulkFieldStatements(fields...)
+	testModels(t, "BulkFieldStatements", statements, map[string]string{
+		"C_EmailAddress": "{{Contact.Field(C_EmailAddress)}}",
+		"C_Country":      "{{Contact.Field(C_Country)}}",
+	})
+}
+
+func TestBulkFieldEndpoints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, endpoint := range []string{"/accounts/fields", "/customObjects/12/fields", "/activities/fields"} {
+		statement := endpoint
+		addBulkHandlerFunc(endpoint, func(w http.ResponseWriter, req *http.Request) {
+			testMethod(t, req, "GET")
+			fmt.Fprintf(w, `{"hasMore":false,"items":[{"name":"Field","statement":"%s"}]}`, statement)
+		})
+	}
+
+	fields, _, err := client.Bulk.AccountFields()
+	if err != nil || len(fields) != 1 || fields[0].Statement != "/accounts/fields" {
+		t.Errorf("Bulk.AccountFields not as expected, Recieved: %+v %v", fields, err)
+	}
+	fields, _, err = client.Bulk.CustomObjectFields(12)
+	if err != nil || len(fields) != 1 || fields[0].Statement != "/customObjects/12/fields" {
+		t.Errorf("Bulk.CustomObjectFields not as expected, Recieved: %+v %v", fields, err)
+	}
+	fields, _, err = client.Bulk.ActivityFields()
+	if err != nil || len(fields) != 1 || fields[0].Statement != "/activities/fields" {
+		t.Errorf("Bulk.ActivityFields not as expected, Recieved: %+v %v", fields, err)
+	}
+}
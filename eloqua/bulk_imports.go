@@ -0,0 +1,254 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"iter"
+	"strings"
+)
+
+// BulkImport represents an Eloqua Bulk API import definition.
+// Fields maps the names of the imported columns to Eloqua Markup Language statements,
+// For example "Email": "{{Contact.Field(C_EmailAddress)}}". IdentifierFieldName is the
+// name of the field used to match imported records to existing ones.
+type BulkImport struct {
+	Name                             string            `json:"name,omitempty"`
+	Fields                           map[string]string `json:"fields,omitempty"`
+	IdentifierFieldName              string            `json:"identifierFieldName,omitempty"`
+	IsSyncTriggeredOnImport          bool              `json:"isSyncTriggeredOnImport,omitempty"`
+	IsUpdatingMultipleMatchedRecords bool              `json:"isUpdatingMultipleMatchedRecords,omitempty"`
+	UpdateRule                       string            `json:"updateRule,omitempty"`
+	SyncActions                      []BulkSyncAction  `json:"syncActions,omitempty"`
+	DataRetentionDuration            string            `json:"dataRetentionDuration,omitempty"`
+	AutoDeleteDuration               string            `json:"autoDeleteDuration,omitempty"`
+	ImportPriorityURI                string            `json:"importPriorityUri,omitempty"`
+
+	// Custom object imports only, Used to map each record to a contact or account
+	MapDataCards                   bool   `json:"mapDataCards,omitempty"`
+	MapDataCardsEntityField        string `json:"mapDataCardsEntityField,omitempty"`
+	MapDataCardsSourceField        string `json:"mapDataCardsSourceField,omitempty"`
+	MapDataCardsEntityType         string `json:"mapDataCardsEntityType,omitempty"`
+	MapDataCardsCaseSensitiveMatch bool   `json:"mapDataCardsCaseSensitiveMatch,omitempty"`
+
+	URI       string `json:"uri,omitempty"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// BulkSyncAction is an action performed on each imported record, Such as
+// adding it to a contact list or setting its subscription status.
+type BulkSyncAction struct {
+	Destination string `json:"destination,omitempty"`
+	Action      string `json:"action,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+// Bulk import update rules, Controlling when existing field values are overwritten.
+const (
+	BulkUpdateAlways           = "always"
+	BulkUpdateIfNewIsNotNull   = "ifNewIsNotNull"
+	BulkUpdateIfExistingIsNull = "ifExistingIsNull"
+)
+
+// BulkSyncReject is a record that was rejected by an import sync.
+type BulkSyncReject struct {
+	FieldValues   map[string]string `json:"fieldValues,omitempty"`
+	Message       string            `json:"message,omitempty"`
+	StatusCode    string            `json:"statusCode,omitempty"`
+	RecordIndex   int               `json:"recordIndex,omitempty"`
+	InvalidFields []string          `json:"invalidFields,omitempty"`
+}
+
+// BulkSyncRejects is a single page of the records rejected by a sync.
+type BulkSyncRejects struct {
+	TotalResults int              `json:"totalResults"`
+	Limit        int              `json:"limit"`
+	Offset       int              `json:"offset"`
+	Count        int              `json:"count"`
+	HasMore      bool             `json:"hasMore"`
+	Items        []BulkSyncReject `json:"items"`
+}
+
+// BulkSyncLog is a log entry recorded while a sync was performed.
+type BulkSyncLog struct {
+	SyncURI    string `json:"syncUri,omitempty"`
+	Count      int    `json:"count,omitempty"`
+	Severity   string `json:"severity,omitempty"`
+	StatusCode string `json:"statusCode,omitempty"`
+	Message    string `json:"message,omitempty"`
+	CreatedAt  string `json:"createdAt,omitempty"`
+}
+
+// BulkSyncLogs is a single page of the log entries of a sync.
+type BulkSyncLogs struct {
+	TotalResults int           `json:"totalResults"`
+	Limit        int           `json:"limit"`
+	Offset       int           `json:"offset"`
+	Count        int           `json:"count"`
+	HasMore      bool          `json:"hasMore"`
+	Items        []BulkSyncLog `json:"items"`
+}
+
+// createImport creates an import definition at the given Bulk API uri.
+func (e *BulkService) createImport(ctx context.Context, uri string, name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	if bulkImport == nil {
+		bulkImport = &BulkImport{}
+	}
+	bulkImport.Name = name
+	resp, err := e.client.postRequestDecode(ctx, bulkEndpoint(uri), bulkImport)
+	return bulkImport, resp, err
+}
+
+// CreateContactImport creates a new contact import definition in eloqua
+func (e *BulkService) CreateContactImport(name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.CreateContactImportWithContext(context.Background(), name, bulkImport)
+}
+
+// CreateContactImportWithContext is like CreateContactImport but performs the request with the given context.
+func (e *BulkService) CreateContactImportWithContext(ctx context.Context, name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.createImport(ctx, "/contacts/imports", name, bulkImport)
+}
+
+// CreateAccountImport creates a new account import definition in eloqua
+func (e *BulkService) CreateAccountImport(name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.CreateAccountImportWithContext(context.Background(), name, bulkImport)
+}
+
+// CreateAccountImportWithContext is like CreateAccountImport but performs the request with the given context.
+func (e *BulkService) CreateAccountImportWithContext(ctx context.Context, name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.createImport(ctx, "/accounts/imports", name, bulkImport)
+}
+
+// CreateCustomObjectImport creates a new import definition for the records of the custom object with the given cdoID
+func (e *BulkService) CreateCustomObjectImport(cdoID int, name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.CreateCustomObjectImportWithContext(context.Background(), cdoID, name, bulkImport)
+}
+
+// CreateCustomObjectImportWithContext is like CreateCustomObjectImport but performs the request with the given context.
+func (e *BulkService) CreateCustomObjectImportWithContext(ctx context.Context, cdoID int, name string, bulkImport *BulkImport) (*BulkImport, *Response, error) {
+	return e.createImport(ctx, fmt.Sprintf("/customObjects/%d/imports", cdoID), name, bulkImport)
+}
+
+// GetImport gets an import definition via its uri, Such as "/contacts/imports/1"
+func (e *BulkService) GetImport(uri string) (*BulkImport, *Response, error) {
+	return e.GetImportWithContext(context.Background(), uri)
+}
+
+// GetImportWithContext is like GetImport but performs the request with the given context.
+func (e *BulkService) GetImportWithContext(ctx context.Context, uri string) (*BulkImport, *Response, error) {
+	bulkImport := &BulkImport{}
+	resp, err := e.client.getRequestDecode(ctx, bulkEndpoint(uri), bulkImport)
+	return bulkImport, resp, err
+}
+
+// DeleteImport deletes an import definition, Along with any staged data, via its uri
+func (e *BulkService) DeleteImport(uri string) (*Response, error) {
+	return e.DeleteImportWithContext(context.Background(), uri)
+}
+
+// DeleteImportWithContext is like DeleteImport but performs the request with the given context.
+func (e *BulkService) DeleteImportWithContext(ctx context.Context, uri string) (*Response, error) {
+	return e.client.deleteRequest(ctx, bulkEndpoint(uri), nil)
+}
+
+// UploadImportData posts a batch of records to the import definition with the given uri.
+// Data should marshal to a JSON array of objects keyed by the import's field names,
+// For example a []map[string]string. Large imports should be split into several batches.
+// If the import has IsSyncTriggeredOnImport set the started sync is returned, Otherwise the sync is nil
+// and a sync must be created once every batch has been uploaded.
+func (e *BulkService) UploadImportData(uri string, data interface{}) (*BulkSync, *Response, error) {
+	return e.UploadImportDataWithContext(context.Background(), uri, data)
+}
+
+// UploadImportDataWithContext is like UploadImportData but performs the request with the given context.
+func (e *BulkService) UploadImportDataWithContext(ctx context.Context, uri string, data interface{}) (*BulkSync, *Response, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := e.client.RestRequestWithContext(ctx, bulkEndpoint(uri)+"/data", "POST", string(jsonData))
+	if resp != nil && resp.Response != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := checkResponse(resp); err != nil {
+		return nil, resp, err
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil || len(strings.TrimSpace(string(content))) == 0 {
+		return nil, resp, err
+	}
+
+	sync := &BulkSync{}
+	err = json.Unmarshal(content, sync)
+	return sync, resp, err
+}
+
+// GetSyncRejects gets a page of the records rejected by the sync with the given uri,
+// Starting at offset and containing at most limit records.
+func (e *BulkService) GetSyncRejects(uri string, offset int, limit int) (*BulkSyncRejects, *Response, error) {
+	return e.GetSyncRejectsWithContext(context.Background(), uri, offset, limit)
+}
+
+// GetSyncRejectsWithContext is like GetSyncRejects but performs the request with the given context.
+func (e *BulkService) GetSyncRejectsWithContext(ctx context.Context, uri string, offset int, limit int) (*BulkSyncRejects, *Response, error) {
+	if limit <= 0 {
+		limit = defaultBulkPageLimit
+	}
+	endpoint := fmt.Sprintf("%s/rejects?offset=%d&limit=%d", bulkEndpoint(uri), offset, limit)
+	rejects := &BulkSyncRejects{}
+	resp, err := e.client.getRequestDecode(ctx, endpoint, rejects)
+	return rejects, resp, err
+}
+
+// SyncRejects returns an iterator over every record rejected by the sync with the given uri,
+// Requesting pages of limit records in turn.
+// Iteration stops after the first error, Which is yielded with a zero-valued reject.
+func (e *BulkService) SyncRejects(ctx context.Context, uri string, limit int) iter.Seq2[BulkSyncReject, error] {
+	return func(yield func(BulkSyncReject, error) bool) {
+		offset := 0
+		for {
+			rejects, _, err := e.GetSyncRejectsWithContext(ctx, uri, offset, limit)
+			if err != nil {
+				yield(BulkSyncReject{}, err)
+				return
+			}
+
+			for _, reject := range rejects.Items {
+				if !yield(reject, nil) {
+					return
+				}
+			}
+
+			offset += len(rejects.Items)
+			if !rejects.HasMore || len(rejects.Items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// GetSyncLogs gets a page of the log entries of the sync with the given uri,
+// Starting at offset and containing at most limit entries.
+func (e *BulkService) GetSyncLogs(uri string, offset int, limit int) (*BulkSyncLogs, *Response, error) {
+	return e.GetSyncLogsWithContext(context.Background(), uri, offset, limit)
+}
+
+// GetSyncLogsWithContext is like GetSyncLogs but performs the request with the given context.
+func (e *BulkService) GetSyncLogsWithContext(ctx context.Context, uri string, offset int, limit int) (*BulkSyncLogs, *Response, error) {
+	if limit <= 0 {
+		limit = defaultBulkPageLimit
+	}
+	endpoint := fmt.Sprintf("%s/logs?offset=%d&limit=%d", bulkEndpoint(uri), offset, limit)
+	logs := &BulkSyncLogs{}
+	resp, err := e.client.getRequestDecode(ctx, endpoint, logs)
+	return logs, resp, err
+}
@@ -0,0 +1,198 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBulkCreateContactImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &BulkImport{
+		Fields:              map[string]string{"Email": "{{Contact.Field(C_EmailAddress)}}"},
+		IdentifierFieldName: "Email",
+		UpdateRule:          BulkUpdateIfNewIsNotNull,
+		SyncActions:         []BulkSyncAction{{Destination: "{{ContactList[12]}}", Action: "add"}},
+	}
+
+	addBulkHandlerFunc("/contacts/imports", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(BulkImport)
+		json.NewDecoder(req.Body).Decode(v)
+		testModels(t, "Bulk.CreateContactImport body", v, &BulkImport{
+			Name:                "Contact Import",
+			Fields:              input.Fields,
+			IdentifierFieldName: "Email",
+			UpdateRule:          BulkUpdateIfNewIsNotNull,
+			SyncActions:         input.SyncActions,
+		})
+
+		fmt.Fprint(w, `{"name":"Contact Import","fields":{"Email":"{{Contact.Field(C_EmailAddress)}}"},"identifierFieldName":"Email","updateRule":"ifNewIsNotNull","syncActions":[{"destination":"{{ContactList[12]}}","action":"add"}],"uri":"/contacts/imports/3"}`)
+	})
+
+	bulkImport, _, err := client.Bulk.CreateContactImport("Contact Import", input)
+	if err != nil {
+		t.Errorf("Bulk.CreateContactImport recieved error: %v", err)
+	}
+	if bulkImport.URI != "/contacts/imports/3" || bulkImport.IdentifierFieldName != "Email" {
+		t.Errorf("Bulk.CreateContactImport not as expected, Recieved: %+v", bulkImport)
+	}
+}
+
+func TestBulkCreateImportEndpoints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, endpoint := range []string{"/accounts/imports", "/customObjects/12/imports"} {
+		uri := endpoint + "/1"
+		addBulkHandlerFunc(endpoint, func(w http.ResponseWriter, req *http.Request) {
+			testMethod(t, req, "POST")
+			fmt.Fprintf(w, `{"name":"Import","uri":"%s"}`, uri)
+		})
+	}
+
+	bulkImport, _, err := client.Bulk.CreateAccountImport("Import", nil)
+	if err != nil || bulkImport.URI != "/accounts/imports/1" {
+		t.Errorf("Bulk.CreateAccountImport not as expected, Recieved: %+v %v", bulkImport, err)
+	}
+	bulkImport, _, err = client.Bulk.CreateCustomObjectImport(12, "Import", &BulkImport{MapDataCards: true})
+	if err != nil || bulkImport.URI != "/customObjects/12/imports/1" {
+		t.Errorf("Bulk.CreateCustomObjectImport not as expected, Recieved: %+v %v", bulkImport, err)
+	}
+}
+
+func TestBulkGetAndDeleteImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/contacts/imports/3", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			fmt.Fprint(w, `{"name":"Contact Import","identifierFieldName":"Email","uri":"/contacts/imports/3"}`)
+		case "DELETE":
+			w.WriteHeader(204)
+		default:
+			t.Errorf("Unexpected method %s", req.Method)
+		}
+	})
+
+	bulkImport, _, err := client.Bulk.GetImport("/contacts/imports/3")
+	if err != nil {
+		t.Errorf("Bulk.GetImport recieved error: %v", err)
+	}
+	testModels(t, "Bulk.GetImport", bulkImport, &BulkImport{Name: "Contact Import", IdentifierFieldName: "Email", URI: "/contacts/imports/3"})
+
+	resp, err := client.Bulk.DeleteImport("/contacts/imports/3")
+	if err != nil || resp.StatusCode != 204 {
+		t.Errorf("Bulk.DeleteImport failed: %v", err)
+	}
+}
+
+func TestBulkUploadImportData(t *testing.T) {
+	setup()
+	defer teardown()
+
+	rows := []map[string]string{{"Email": "test1@example.com"}, {"Email": "test2@example.com"}}
+
+	addBulkHandlerFunc("/contacts/imports/3/data", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := []map[string]string{}
+		json.NewDecoder(req.Body).Decode(&v)
+		testModels(t, "Bulk.UploadImportData body", v, rows)
+		w.WriteHeader(204)
+	})
+
+	addBulkHandlerFunc("/contacts/imports/4/data", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"syncedInstanceUri":"/contacts/imports/4","status":"pending","uri":"/syncs/10"}`)
+	})
+
+	sync, _, err := client.Bulk.UploadImportData("/contacts/imports/3", rows)
+	if err != nil {
+		t.Errorf("Bulk.UploadImportData recieved error: %v", err)
+	}
+	if sync != nil {
+		t.Errorf("Expected no sync when the import does not trigger a sync, Recieved: %+v", sync)
+	}
+
+	sync, _, err = client.Bulk.UploadImportData("/contacts/imports/4", rows)
+	if err != nil {
+		t.Errorf("Bulk.UploadImportData recieved error: %v", err)
+	}
+	if sync == nil || sync.URI != "/syncs/10" {
+		t.Errorf("Expected the triggered sync to be returned, Recieved: %+v", sync)
+	}
+}
+
+func TestBulkUploadImportDataError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/contacts/imports/3/data", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(404)
+	})
+
+	_, _, err := client.Bulk.UploadImportData("/contacts/imports/3", []map[string]string{})
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found error, Recieved: %v", err)
+	}
+}
+
+func TestBulkSyncRejects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/syncs/10/rejects", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		if offset := req.URL.Query().Get("offset"); offset == "0" {
+			testURLParam(t, req, "limit", "1")
+			fmt.Fprint(w, `{"totalResults":2,"limit":1,"offset":0,"count":1,"hasMore":true,"items":[{"fieldValues":{"Email":"bad"},"message":"Invalid email address.","statusCode":"ELQ-00107","recordIndex":1,"invalidFields":["Email"]}]}`)
+		} else {
+			fmt.Fprint(w, `{"totalResults":2,"limit":1,"offset":1,"count":1,"hasMore":false,"items":[{"fieldValues":{"Email":""},"message":"Missing email address.","statusCode":"ELQ-00107","recordIndex":2,"invalidFields":["Email"]}]}`)
+		}
+	})
+
+	rejects, _, err := client.Bulk.GetSyncRejects("/syncs/10", 0, 1)
+	if err != nil {
+		t.Fatalf("Bulk.GetSyncRejects recieved error: %v", err)
+	}
+	want := BulkSyncReject{FieldValues: map[string]string{"Email": "bad"}, Message: "Invalid email address.", StatusCode: "ELQ-00107", RecordIndex: 1, InvalidFields: []string{"Email"}}
+	if rejects.TotalResults != 2 || !rejects.HasMore || len(rejects.Items) != 1 {
+		t.Fatalf("Bulk.GetSyncRejects not as expected, Recieved: %+v", rejects)
+	}
+	testModels(t, "Bulk.GetSyncRejects", rejects.Items[0], want)
+
+	indexes := []int{}
+	for reject, err := range client.Bulk.SyncRejects(context.Background(), "/syncs/10", 1) {
+		if err != nil {
+			t.Fatalf("Bulk.SyncRejects recieved error: %v", err)
+		}
+		indexes = append(indexes, reject.RecordIndex)
+	}
+	testModels(t, "Bulk.SyncRejects record indexes", indexes, []int{1, 2})
+}
+
+func TestBulkGetSyncLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/syncs/10/logs", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		testURLParam(t, req, "limit", "1000")
+		fmt.Fprint(w, `{"totalResults":1,"limit":1000,"offset":0,"count":1,"hasMore":false,"items":[{"syncUri":"/syncs/10","count":48,"severity":"information","statusCode":"ELQ-00040","message":"Successfully imported records.","createdAt":"2024-01-02T03:04:05.000Z"}]}`)
+	})
+
+	logs, _, err := client.Bulk.GetSyncLogs("/syncs/10", 0, 0)
+	if err != nil {
+		t.Fatalf("Bulk.GetSyncLogs recieved error: %v", err)
+	}
+
+	want := &BulkSyncLogs{TotalResults: 1, Limit: 1000, Count: 1, Items: []BulkSyncLog{
+		{SyncURI: "/syncs/10", Count: 48, Severity: "information", StatusCode: "ELQ-00040", Message: "Successfully imported records.", CreatedAt: "2024-01-02T03:04:05.000Z"},
+	}}
+	testModels(t, "Bulk.GetSyncLogs", logs, want)
+}
@@ -0,0 +1,240 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBulkCreateContactExport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &BulkExport{
+		Fields: map[string]string{"Email": "{{Contact.Field(C_EmailAddress)}}"},
+		Filter: "'{{Contact.Field(C_Country)}}' = 'UK'",
+	}
+
+	addBulkHandlerFunc("/contacts/exports", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(BulkExport)
+		json.NewDecoder(req.Body).Decode(v)
+		testModels(t, "Bulk.CreateContactExport body", v, &BulkExport{Name: "UK Contacts", Fields: input.Fields, Filter: input.Filter})
+
+		fmt.Fprint(w, `{"name":"UK Contacts","fields":{"Email":"{{Contact.Field(C_EmailAddress)}}"},"filter":"'{{Contact.Field(C_Country)}}' = 'UK'","uri":"/contacts/exports/7","createdBy":"John.Smith"}`)
+	})
+
+	export, _, err := client.Bulk.CreateContactExport("UK Contacts", input)
+	if err != nil {
+		t.Errorf("Bulk.CreateContactExport recieved error: %v", err)
+	}
+
+	want := &BulkExport{Name: "UK Contacts", Fields: input.Fields, Filter: input.Filter, URI: "/contacts/exports/7", CreatedBy: "John.Smith"}
+	testModels(t, "Bulk.CreateContactExport", export, want)
+}
+
+func TestBulkCreateExportEndpoints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, endpoint := range []string{"/accounts/exports", "/customObjects/12/exports", "/activities/exports"} {
+		uri := endpoint + "/1"
+		addBulkHandlerFunc(endpoint, func(w http.ResponseWriter, req *http.Request) {
+			testMethod(t, req, "POST")
+			fmt.Fprintf(w, `{"name":"Export","uri":"%s"}`, uri)
+		})
+	}
+
+	export, _, err := client.Bulk.CreateAccountExport("Export", nil)
+	if err != nil || export.URI != "/accounts/exports/1" {
+		t.Errorf("Bulk.CreateAccountExport not as expected, Recieved: %+v %v", export, err)
+	}
+	export, _, err = client.Bulk.CreateCustomObjectExport(12, "Export", nil)
+	if err != nil || export.URI != "/customObjects/12/exports/1" {
+		t.Errorf("Bulk.CreateCustomObjectExport not as expected, Recieved: %+v %v", export, err)
+	}
+	export, _, err = client.Bulk.CreateActivityExport("Export", nil)
+	if err != nil || export.URI != "/activities/exports/1" {
+		t.Errorf("Bulk.CreateActivityExport not as expected, Recieved: %+v %v", export, err)
+	}
+}
+
+func TestBulkGetAndDeleteExport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/contacts/exports/7", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			fmt.Fprint(w, `{"name":"UK Contacts","uri":"/contacts/exports/7"}`)
+		case "DELETE":
+			w.WriteHeader(204)
+		default:
+			t.Errorf("Unexpected method %s", req.Method)
+		}
+	})
+
+	export, _, err := client.Bulk.GetExport("/contacts/exports/7")
+	if err != nil {
+		t.Errorf("Bulk.GetExport recieved error: %v", err)
+	}
+	testModels(t, "Bulk.GetExport", export, &BulkExport{Name: "UK Contacts", URI: "/contacts/exports/7"})
+
+	resp, err := client.Bulk.DeleteExport("/contacts/exports/7")
+	if err != nil || resp.StatusCode != 204 {
+		t.Errorf("Bulk.DeleteExport failed: %v", err)
+	}
+}
+
+func TestBulkSyncAndWait(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/syncs", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(BulkSync)
+		json.NewDecoder(req.Body).Decode(v)
+		testModels(t, "Bulk.CreateSync body", v, &BulkSync{SyncedInstanceURI: "/contacts/exports/7"})
+		fmt.Fprint(w, `{"syncedInstanceUri":"/contacts/exports/7","status":"pending","uri":"/syncs/9"}`)
+	})
+
+	polls := 0
+	addBulkHandlerFunc("/syncs/9", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		polls++
+		status := BulkSyncActive
+		if polls == 3 {
+			status = BulkSyncSuccess
+		}
+		fmt.Fprintf(w, `{"syncedInstanceUri":"/contacts/exports/7","status":"%s","uri":"/syncs/9"}`, status)
+	})
+
+	sync, _, err := client.Bulk.CreateSync("/contacts/exports/7", nil)
+	if err != nil {
+		t.Fatalf("Bulk.CreateSync recieved error: %v", err)
+	}
+	if sync.URI != "/syncs/9" || sync.IsComplete() {
+		t.Errorf("Bulk.CreateSync not as expected, Recieved: %+v", sync)
+	}
+
+	sync, _, err = client.Bulk.WaitForSync(sync.URI, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Bulk.WaitForSync recieved error: %v", err)
+	}
+	if sync.Status != BulkSyncSuccess || polls != 3 {
+		t.Errorf("Bulk.WaitForSync not as expected, Recieved status %s after %d polls", sync.Status, polls)
+	}
+}
+
+func TestBulkWaitForSyncContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addBulkHandlerFunc("/syncs/9", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"status":"active","uri":"/syncs/9"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Bulk.WaitForSyncWithContext(ctx, "/syncs/9", time.Hour)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+}
+
+func TestBulkWaitForSyncDefaultInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	addBulkHandlerFunc("/syncs/9", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		fmt.Fprint(w, `{"status":"active","uri":"/syncs/9"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Bulk.WaitForSyncWithContext(ctx, "/syncs/9", 0)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+	if polls != 1 {
+		t.Errorf("Expected a single poll using the default interval, Recieved %d polls", polls)
+	}
+}
+
+// addSyncDataHandler serves 5 exported records in pages of the requested limit.
+func addSyncDataHandler(t *testing.T) {
+	addBulkHandlerFunc("/syncs/9/data", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		var offset, limit int
+		fmt.Sscan(req.URL.Query().Get("offset"), &offset)
+		fmt.Sscan(req.URL.Query().Get("limit"), &limit)
+
+		items := []map[string]string{}
+		for i := offset; i < offset+limit && i < 5; i++ {
+			items = append(items, map[string]string{"ContactID": fmt.Sprint(i + 1), "Email": fmt.Sprintf("test%d@example.com", i+1)})
+		}
+		data := map[string]interface{}{"totalResults": 5, "limit": limit, "offset": offset, "count": len(items), "hasMore": offset+len(items) < 5, "items": items}
+		json.NewEncoder(w).Encode(data)
+	})
+}
+
+func TestBulkGetSyncData(t *testing.T) {
+	setup()
+	defer teardown()
+	addSyncDataHandler(t)
+
+	data, _, err := client.Bulk.GetSyncData("/syncs/9", 2, 2)
+	if err != nil {
+		t.Fatalf("Bulk.GetSyncData recieved error: %v", err)
+	}
+	if data.TotalResults != 5 || data.Offset != 2 || data.Count != 2 || !data.HasMore || len(data.Items) != 2 {
+		t.Errorf("Bulk.GetSyncData not as expected, Recieved: %+v", data)
+	}
+}
+
+func TestBulkSyncData(t *testing.T) {
+	setup()
+	defer teardown()
+	addSyncDataHandler(t)
+
+	emails := []string{}
+	for row, err := range client.Bulk.SyncData(context.Background(), "/syncs/9", 2) {
+		if err != nil {
+			t.Fatalf("Bulk.SyncData recieved error: %v", err)
+		}
+		emails = append(emails, row["Email"])
+	}
+
+	want := []string{"test1@example.com", "test2@example.com", "test3@example.com", "test4@example.com", "test5@example.com"}
+	testModels(t, "Bulk.SyncData emails", emails, want)
+}
+
+func TestBulkSyncRowsTyped(t *testing.T) {
+	setup()
+	defer teardown()
+	addSyncDataHandler(t)
+
+	type exportedContact struct {
+		ContactID string `json:"ContactID"`
+		Email     string `json:"Email"`
+	}
+
+	rows := []exportedContact{}
+	for row, err := range BulkSyncRows[exportedContact](context.Background(), client.Bulk, "/syncs/9", 3) {
+		if err != nil {
+			t.Fatalf("BulkSyncRows recieved error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) != 5 || rows[4] != (exportedContact{ContactID: "5", Email: "test5@example.com"}) {
+		t.Errorf("BulkSyncRows not as expected, Recieved: %+v", rows)
+	}
+}
@@ -0,0 +1,205 @@
+package eloqua
+
+import (
+	"fmt"
+	"time"
+)
+
+// Campaign element types
+const (
+	CampaignSegmentStep        = "CampaignSegment"
+	CampaignEmailStep          = "CampaignEmail"
+	CampaignLandingPageStep    = "CampaignLandingPage"
+	CampaignWaitStep           = "CampaignWaitAction"
+	CampaignSharedFilterStep   = "CampaignContactFilterMembershipRule"
+	CampaignListMembershipStep = "CampaignContactListMembershipRule"
+	CampaignEmailOpenedStep    = "CampaignEmailOpenedRule"
+	CampaignEmailClickedStep   = "CampaignEmailClickthroughRule"
+	CampaignFormSubmittedStep  = "CampaignSubmitFormRule"
+	CampaignFormListenerStep   = "CampaignFormSubmitListener"
+	CampaignAddToListStep      = "CampaignAddToContactListAction"
+	CampaignAddToProgramStep   = "CampaignAddToProgramBuilderAction"
+)
+
+// Campaign output terminal types
+const (
+	CampaignTerminalOut = "out"
+	CampaignTerminalYes = "yes"
+	CampaignTerminalNo  = "no"
+)
+
+// campaignStepKind describes how a kind of campaign element behaves on the canvas
+type campaignStepKind struct {
+	entry    bool
+	decision bool
+	asset    string
+	assetID  func(e *CampaignElement) int
+	// Action-specific properties used by the kind, Other than its asset
+	fields []string
+}
+
+var campaignStepKinds = map[string]campaignStepKind{
+	CampaignSegmentStep:        {entry: true, asset: "segmentId", assetID: func(e *CampaignElement) int { return e.SegmentID }},
+	CampaignEmailStep:          {asset: "emailId", assetID: func(e *CampaignElement) int { return e.EmailID }},
+	CampaignLandingPageStep:    {asset: "landingPageId", assetID: func(e *CampaignElement) int { return e.LandingPageID }},
+	CampaignWaitStep:           {fields: []string{"waitFor", "waitUntil"}},
+	CampaignSharedFilterStep:   {decision: true, asset: "filterId", assetID: func(e *CampaignElement) int { return e.FilterID }},
+	CampaignListMembershipStep: {decision: true, asset: "listId", assetID: func(e *CampaignElement) int { return e.ListID }},
+	CampaignEmailOpenedStep:    {decision: true, asset: "emailId", assetID: func(e *CampaignElement) int { return e.EmailID }},
+	CampaignEmailClickedStep:   {decision: true, asset: "emailId", assetID: func(e *CampaignElement) int { return e.EmailID }},
+	CampaignFormSubmittedStep:  {decision: true, asset: "formId", assetID: func(e *CampaignElement) int { return e.FormID }},
+	CampaignFormListenerStep:   {entry: true, asset: "formId", assetID: func(e *CampaignElement) int { return e.FormID }},
+	CampaignAddToListStep:      {asset: "listId", assetID: func(e *CampaignElement) int { return e.ListID }},
+	CampaignAddToProgramStep:   {asset: "programElementId", assetID: func(e *CampaignElement) int { return e.ProgramElementID }},
+}
+
+// campaignStepFields lists the action-specific properties of a campaign element
+// with whether each is set, Used to check elements only set the properties their kind uses.
+var campaignStepFields = []struct {
+	name  string
+	isSet func(e *CampaignElement) bool
+}{
+	{"segmentId", func(e *CampaignElement) bool { return e.SegmentID != 0 }},
+	{"emailId", func(e *CampaignElement) bool { return e.EmailID != 0 }},
+	{"formId", func(e *CampaignElement) bool { return e.FormID != 0 }},
+	{"landingPageId", func(e *CampaignElement) bool { return e.LandingPageID != 0 }},
+	{"listId", func(e *CampaignElement) bool { return e.ListID != 0 }},
+	{"filterId", func(e *CampaignElement) bool { return e.FilterID != 0 }},
+	{"programElementId", func(e *CampaignElement) bool { return e.ProgramElementID != 0 }},
+	{"waitFor", func(e *CampaignElement) bool { return e.WaitFor != 0 }},
+	{"waitUntil", func(e *CampaignElement) bool { return !e.WaitUntil.IsZero() }},
+	{"evaluateNoAfter", func(e *CampaignElement) bool { return e.EvaluateNoAfter != 0 }},
+}
+
+// uses reports whether the kind of step uses the given action-specific property.
+func (k campaignStepKind) uses(field string) bool {
+	if field == k.asset || (k.decision && field == "evaluateNoAfter") {
+		return true
+	}
+	for _, f := range k.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEntryPoint reports whether contacts enter the campaign through the element,
+// Such as segment members or a form listener.
+func (e *CampaignElement) IsEntryPoint() bool {
+	return campaignStepKinds[e.Type].entry
+}
+
+// IsDecision reports whether the element is a decision step with yes & no terminals.
+func (e *CampaignElement) IsDecision() bool {
+	return campaignStepKinds[e.Type].decision
+}
+
+// NewCampaignSegmentStep creates a step adding the members of the segment with the given ID to the campaign.
+func NewCampaignSegmentStep(segmentID int) CampaignElement {
+	return CampaignElement{Type: CampaignSegmentStep, SegmentID: segmentID}
+}
+
+// NewCampaignEmailStep creates a step sending the email with the given ID.
+func NewCampaignEmailStep(emailID int) CampaignElement {
+	return CampaignElement{Type: CampaignEmailStep, EmailID: emailID}
+}
+
+// NewCampaignLandingPageStep creates a step for the landing page with the given ID.
+func NewCampaignLandingPageStep(landingPageID int) CampaignElement {
+	return CampaignElement{Type: CampaignLandingPageStep, LandingPageID: landingPageID}
+}
+
+// NewCampaignWaitStep creates a step holding contacts for the given duration, To the nearest second.
+func NewCampaignWaitStep(d time.Duration) CampaignElement {
+	return CampaignElement{Type: CampaignWaitStep, WaitFor: int(d / time.Second)}
+}
+
+// NewCampaignWaitUntilStep creates a step holding contacts until the given time.
+func NewCampaignWaitUntilStep(t time.Time) CampaignElement {
+	return CampaignElement{Type: CampaignWaitStep, WaitUntil: NewTimestamp(t)}
+}
+
+// NewCampaignSharedFilterStep creates a decision step on membership of the shared filter with the given ID.
+func NewCampaignSharedFilterStep(filterID int) CampaignElement {
+	return CampaignElement{Type: CampaignSharedFilterStep, FilterID: filterID}
+}
+
+// NewCampaignListMembershipStep creates a decision step on membership of the contact list with the given ID.
+func NewCampaignListMembershipStep(listID int) CampaignElement {
+	return CampaignElement{Type: CampaignListMembershipStep, ListID: listID}
+}
+
+// NewCampaignEmailOpenedStep creates a decision step on whether the email with the given ID was opened.
+func NewCampaignEmailOpenedStep(emailID int) CampaignElement {
+	return CampaignElement{Type: CampaignEmailOpenedStep, EmailID: emailID}
+}
+
+// NewCampaignEmailClickedStep creates a decision step on whether the email with the given ID was clicked.
+func NewCampaignEmailClickedStep(emailID int) CampaignElement {
+	return CampaignElement{Type: CampaignEmailClickedStep, EmailID: emailID}
+}
+
+// NewCampaignFormSubmittedStep creates a decision step on whether the form with the given ID was submitted.
+func NewCampaignFormSubmittedStep(formID int) CampaignElement {
+	return CampaignElement{Type: CampaignFormSubmittedStep, FormID: formID}
+}
+
+// NewCampaignFormListenerStep creates a step adding contacts to the campaign as they submit the form with the given ID.
+func NewCampaignFormListenerStep(formID int) CampaignElement {
+	return CampaignElement{Type: CampaignFormListenerStep, FormID: formID}
+}
+
+// NewCampaignAddToListStep creates a step adding contacts to the contact list with the given ID.
+func NewCampaignAddToListStep(listID int) CampaignElement {
+	return CampaignElement{Type: CampaignAddToListStep, ListID: listID}
+}
+
+// NewCampaignAddToProgramStep creates a step adding contacts to the program step with the given ID.
+func NewCampaignAddToProgramStep(programElementID int) CampaignElement {
+	return CampaignElement{Type: CampaignAddToProgramStep, ProgramElementID: programElementID}
+}
+
+// AddElement adds the element to the campaign and returns its ID.
+// Elements without an ID are given a new negative ID, As Eloqua expects for new elements.
+func (c *Campaign) AddElement(element CampaignElement) int {
+	if element.ID == 0 {
+		element.ID = -1
+		for _, e := range c.Elements {
+			if e.ID <= element.ID {
+				element.ID = e.ID - 1
+			}
+		}
+	}
+	c.Elements = append(c.Elements, element)
+	return element.ID
+}
+
+// Connect links the given terminal of one campaign element to another element,
+// Using CampaignTerminalOut, CampaignTerminalYes or CampaignTerminalNo as the terminal type.
+func (c *Campaign) Connect(fromID int, terminalType string, toID int) error {
+	var from, to *CampaignElement
+	for i := range c.Elements {
+		if c.Elements[i].ID == fromID {
+			from = &c.Elements[i]
+		}
+		if c.Elements[i].ID == toID {
+			to = &c.Elements[i]
+		}
+	}
+
+	if from == nil {
+		return fmt.Errorf("Campaign element %d not found", fromID)
+	}
+	if to == nil {
+		return fmt.Errorf("Campaign element %d not found", toID)
+	}
+
+	from.OutputTerminals = append(from.OutputTerminals, CampaignOutputTerminal{
+		Type:          "CampaignOutputTerminal",
+		ConnectedID:   to.ID,
+		ConnectedType: to.Type,
+		TerminalType:  terminalType,
+	})
+	return nil
+}
@@ -0,0 +1,57 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCampaignElementKinds(t *testing.T) {
+	segment := NewCampaignSegmentStep(352)
+	if !segment.IsEntryPoint() || segment.IsDecision() {
+		t.Errorf("Segment step kind not as expected, Recieved: %+v", segment)
+	}
+
+	filter := NewCampaignSharedFilterStep(12)
+	if filter.IsEntryPoint() || !filter.IsDecision() || filter.FilterID != 12 {
+		t.Errorf("Shared filter step kind not as expected, Recieved: %+v", filter)
+	}
+
+	wait := NewCampaignWaitStep(2 * time.Hour)
+	if wait.Type != CampaignWaitStep || wait.WaitFor != 7200 {
+		t.Errorf("Wait step not as expected, Recieved: %+v", wait)
+	}
+
+	unknown := CampaignElement{Type: "CampaignCloudAction"}
+	if unknown.IsEntryPoint() || unknown.IsDecision() {
+		t.Errorf("Unknown step kind not as expected, Recieved: %+v", unknown)
+	}
+}
+
+func TestCampaignAddElementAndConnect(t *testing.T) {
+	campaign := &Campaign{}
+	segmentID := campaign.AddElement(NewCampaignSegmentStep(352))
+	emailID := campaign.AddElement(NewCampaignEmailStep(40))
+	existingID := campaign.AddElement(CampaignElement{Type: CampaignAddToProgramStep, ID: 900, ProgramElementID: 5})
+
+	if segmentID != -1 || emailID != -2 || existingID != 900 {
+		t.Fatalf("Campaign.AddElement IDs not as expected, Recieved: %d, %d, %d", segmentID, emailID, existingID)
+	}
+
+	if err := campaign.Connect(segmentID, CampaignTerminalOut, emailID); err != nil {
+		t.Fatalf("Campaign.Connect recieved error: %v", err)
+	}
+	if err := campaign.Connect(emailID, CampaignTerminalOut, -10); err == nil {
+		t.Error("Campaign.Connect expected error for a missing element")
+	}
+
+	b, err := json.Marshal(campaign.Elements[0])
+	if err != nil {
+		t.Fatalf("json.Marshal recieved error: %v", err)
+	}
+
+	want := `{"type":"CampaignSegment","id":"-1","outputTerminals":[{"type":"CampaignOutputTerminal","connectedId":"-2","connectedType":"CampaignEmail","terminalType":"out"}],"position":{},"segmentId":"352"}`
+	if string(b) != want {
+		t.Errorf("Campaign element JSON not as expected\nRecieved: %s\nWanted:   %s", b, want)
+	}
+}
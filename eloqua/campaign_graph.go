@@ -0,0 +1,234 @@
+package eloqua
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// CampaignGraph is a view of a campaign's elements as a graph,
+// Linking each element to those connected to its output terminals.
+// The graph reflects the campaign at the time it was created.
+type CampaignGraph struct {
+	elements map[int]*CampaignElement
+	order    []int
+	previous map[int][]int
+}
+
+// Graph creates a graph of the campaign's elements.
+func (c *Campaign) Graph() *CampaignGraph {
+	g := &CampaignGraph{
+		elements: make(map[int]*CampaignElement, len(c.Elements)),
+		previous: make(map[int][]int),
+	}
+
+	for i := range c.Elements {
+		e := &c.Elements[i]
+		if _, ok := g.elements[e.ID]; ok {
+			continue
+		}
+		g.elements[e.ID] = e
+		g.order = append(g.order, e.ID)
+	}
+
+	for _, id := range g.order {
+		for _, terminal := range g.elements[id].OutputTerminals {
+			if _, ok := g.elements[terminal.ConnectedID]; ok {
+				g.previous[terminal.ConnectedID] = append(g.previous[terminal.ConnectedID], id)
+			}
+		}
+	}
+
+	return g
+}
+
+// Element returns the element with the given ID
+func (g *CampaignGraph) Element(id int) (*CampaignElement, bool) {
+	e, ok := g.elements[id]
+	return e, ok
+}
+
+// Next returns the elements connected to the output terminals of the element with the given ID.
+// Terminals connected to missing elements are skipped.
+func (g *CampaignGraph) Next(id int) []*CampaignElement {
+	e, ok := g.elements[id]
+	if !ok {
+		return nil
+	}
+
+	var next []*CampaignElement
+	for _, terminal := range e.OutputTerminals {
+		if connected, ok := g.elements[terminal.ConnectedID]; ok {
+			next = append(next, connected)
+		}
+	}
+	return next
+}
+
+// Previous returns the elements with an output terminal connected to the element with the given ID.
+func (g *CampaignGraph) Previous(id int) []*CampaignElement {
+	var previous []*CampaignElement
+	for _, prevID := range g.previous[id] {
+		previous = append(previous, g.elements[prevID])
+	}
+	return previous
+}
+
+// EntryPoints returns the elements through which contacts enter the campaign.
+func (g *CampaignGraph) EntryPoints() []*CampaignElement {
+	var entries []*CampaignElement
+	for _, id := range g.order {
+		if g.elements[id].IsEntryPoint() {
+			entries = append(entries, g.elements[id])
+		}
+	}
+	return entries
+}
+
+// Walk iterates over the elements reachable from the campaign's entry points,
+// Visiting each element once in breadth first order.
+func (g *CampaignGraph) Walk() iter.Seq[*CampaignElement] {
+	return func(yield func(*CampaignElement) bool) {
+		queue := g.EntryPoints()
+		seen := make(map[int]bool, len(g.elements))
+		for _, e := range queue {
+			seen[e.ID] = true
+		}
+
+		for len(queue) > 0 {
+			e := queue[0]
+			queue = queue[1:]
+			if !yield(e) {
+				return
+			}
+			for _, next := range g.Next(e.ID) {
+				if !seen[next.ID] {
+					seen[next.ID] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+}
+
+// CampaignProblem describes a single problem found when validating a campaign.
+// ElementID is zero for problems with the campaign as a whole.
+type CampaignProblem struct {
+	ElementID int
+	Message   string
+}
+
+func (p CampaignProblem) String() string {
+	if p.ElementID == 0 {
+		return p.Message
+	}
+	return fmt.Sprintf("element %d: %s", p.ElementID, p.Message)
+}
+
+// CampaignValidationError is returned by Campaign.Validate and lists every problem found.
+type CampaignValidationError struct {
+	Problems []CampaignProblem
+}
+
+func (e *CampaignValidationError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		problems[i] = p.String()
+	}
+	return fmt.Sprintf("Campaign is invalid: %s", strings.Join(problems, "; "))
+}
+
+// Validate checks the campaign's elements form a usable canvas before it is created or updated.
+// Elements must have unique IDs, Terminals must connect to existing elements & every element must be
+// reachable from an entry point such as a segment. Known kinds of step are also checked for the
+// asset they act on & the terminals they use, And must not set the properties of other kinds of step,
+// Such as a form ID on an email step. Wait steps must wait for a positive duration or until a time, But not both.
+// A *CampaignValidationError is returned listing the problems found.
+func (c *Campaign) Validate() error {
+	if len(c.Elements) == 0 {
+		return nil
+	}
+
+	var problems []CampaignProblem
+	add := func(id int, format string, a ...interface{}) {
+		problems = append(problems, CampaignProblem{ElementID: id, Message: fmt.Sprintf(format, a...)})
+	}
+
+	ids := make(map[int]bool, len(c.Elements))
+	for _, e := range c.Elements {
+		if e.ID == 0 {
+			add(0, "%s element has no ID", e.Type)
+		} else if ids[e.ID] {
+			add(e.ID, "duplicate element ID")
+		}
+		ids[e.ID] = true
+	}
+
+	g := c.Graph()
+	for i := range c.Elements {
+		e := &c.Elements[i]
+		kind, known := campaignStepKinds[e.Type]
+
+		if known && kind.assetID != nil && kind.assetID(e) == 0 {
+			add(e.ID, "%s step has no %s", e.Type, kind.asset)
+		}
+		if e.Type == CampaignWaitStep {
+			switch {
+			case e.WaitFor == 0 && e.WaitUntil.IsZero():
+				add(e.ID, "wait step has no waitFor or waitUntil")
+			case e.WaitFor != 0 && !e.WaitUntil.IsZero():
+				add(e.ID, "wait step has both waitFor and waitUntil")
+			case e.WaitFor < 0:
+				add(e.ID, "wait step has a negative waitFor")
+			}
+		}
+		if known {
+			for _, field := range campaignStepFields {
+				if field.isSet(e) && !kind.uses(field.name) {
+					add(e.ID, "%s step does not use %s", e.Type, field.name)
+				}
+			}
+		}
+
+		for _, terminal := range e.OutputTerminals {
+			if known {
+				valid := terminal.TerminalType == CampaignTerminalOut
+				if kind.decision {
+					valid = terminal.TerminalType == CampaignTerminalYes || terminal.TerminalType == CampaignTerminalNo
+				}
+				if !valid {
+					add(e.ID, "%s step cannot use the '%s' terminal", e.Type, terminal.TerminalType)
+				}
+			}
+
+			connected, ok := g.Element(terminal.ConnectedID)
+			switch {
+			case terminal.ConnectedID == 0:
+				add(e.ID, "'%s' terminal is not connected", terminal.TerminalType)
+			case !ok:
+				add(e.ID, "'%s' terminal connects to missing element %d", terminal.TerminalType, terminal.ConnectedID)
+			case terminal.ConnectedType != "" && terminal.ConnectedType != connected.Type:
+				add(e.ID, "'%s' terminal connects to %s %d but is marked as %s", terminal.TerminalType, connected.Type, connected.ID, terminal.ConnectedType)
+			}
+		}
+	}
+
+	if len(g.EntryPoints()) == 0 {
+		add(0, "campaign has no entry point")
+	} else {
+		reached := make(map[int]bool, len(c.Elements))
+		for e := range g.Walk() {
+			reached[e.ID] = true
+		}
+		for _, id := range g.order {
+			if id != 0 && !reached[id] {
+				add(id, "not reachable from an entry point")
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &CampaignValidationError{Problems: problems}
+	}
+	return nil
+}
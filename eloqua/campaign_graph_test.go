@@ -0,0 +1,144 @@
+package eloqua
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// testCampaign builds a campaign of segment -> email -> opened decision -> (yes) add to list, (no) wait -> email
+func testCampaign(t *testing.T) *Campaign {
+	campaign := &Campaign{}
+	segment := campaign.AddElement(NewCampaignSegmentStep(352))
+	email := campaign.AddElement(NewCampaignEmailStep(40))
+	opened := campaign.AddElement(NewCampaignEmailOpenedStep(40))
+	list := campaign.AddElement(NewCampaignAddToListStep(55))
+	wait := campaign.AddElement(CampaignElement{Type: CampaignWaitStep, WaitFor: 86400})
+
+	links := []struct {
+		from     int
+		terminal string
+		to       int
+	}{
+		{segment, CampaignTerminalOut, email},
+		{email, CampaignTerminalOut, opened},
+		{opened, CampaignTerminalYes, list},
+		{opened, CampaignTerminalNo, wait},
+		{wait, CampaignTerminalOut, email},
+	}
+	for _, l := range links {
+		if err := campaign.Connect(l.from, l.terminal, l.to); err != nil {
+			t.Fatalf("Campaign.Connect recieved error: %v", err)
+		}
+	}
+	return campaign
+}
+
+func TestCampaignGraph(t *testing.T) {
+	g := testCampaign(t).Graph()
+
+	entries := g.EntryPoints()
+	if len(entries) != 1 || entries[0].ID != -1 {
+		t.Fatalf("CampaignGraph.EntryPoints not as expected, Recieved: %+v", entries)
+	}
+
+	next := g.Next(-3)
+	if len(next) != 2 || next[0].ID != -4 || next[1].ID != -5 {
+		t.Errorf("CampaignGraph.Next not as expected, Recieved: %+v", next)
+	}
+
+	previous := g.Previous(-2)
+	if len(previous) != 2 || previous[0].ID != -1 || previous[1].ID != -5 {
+		t.Errorf("CampaignGraph.Previous not as expected, Recieved: %+v", previous)
+	}
+
+	if e, ok := g.Element(-4); !ok || e.ListID != 55 {
+		t.Errorf("CampaignGraph.Element not as expected, Recieved: %+v", e)
+	}
+
+	var walked []int
+	for e := range g.Walk() {
+		walked = append(walked, e.ID)
+	}
+	testModels(t, "CampaignGraph.Walk", walked, []int{-1, -2, -3, -4, -5})
+}
+
+func TestCampaignValidate(t *testing.T) {
+	if err := testCampaign(t).Validate(); err != nil {
+		t.Errorf("Campaign.Validate recieved error: %v", err)
+	}
+
+	if err := (&Campaign{}).Validate(); err != nil {
+		t.Errorf("Campaign.Validate recieved error for an empty campaign: %v", err)
+	}
+}
+
+func TestCampaignValidateProblems(t *testing.T) {
+	campaign := testCampaign(t)
+	campaign.Elements[1].OutputTerminals[0].ConnectedID = -20
+	campaign.Elements[2].OutputTerminals[0].TerminalType = CampaignTerminalOut
+	campaign.Elements[3].ListID = 0
+	campaign.AddElement(NewCampaignLandingPageStep(7))
+
+	err := campaign.Validate()
+
+	var validationErr *CampaignValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Campaign.Validate expected a *CampaignValidationError, Recieved: %v", err)
+	}
+
+	want := []CampaignProblem{
+		{ElementID: -2, Message: "'out' terminal connects to missing element -20"},
+		{ElementID: -3, Message: "CampaignEmailOpenedRule step cannot use the 'out' terminal"},
+		{ElementID: -4, Message: "CampaignAddToContactListAction step has no listId"},
+		{ElementID: -3, Message: "not reachable from an entry point"},
+		{ElementID: -4, Message: "not reachable from an entry point"},
+		{ElementID: -5, Message: "not reachable from an entry point"},
+		{ElementID: -6, Message: "not reachable from an entry point"},
+	}
+	testModels(t, "Campaign.Validate problems", validationErr.Problems, want)
+}
+
+func TestCampaignValidateNoEntryPoint(t *testing.T) {
+	campaign := &Campaign{}
+	campaign.AddElement(NewCampaignEmailStep(40))
+	campaign.AddElement(CampaignElement{Type: CampaignWaitStep, ID: -1})
+
+	err := campaign.Validate()
+	want := "Campaign is invalid: element -1: duplicate element ID; element -1: wait step has no waitFor or waitUntil; campaign has no entry point"
+	if err == nil || err.Error() != want {
+		t.Errorf("Campaign.Validate error not as expected, Recieved: %v", err)
+	}
+}
+
+func TestCampaignValidateStepFields(t *testing.T) {
+	campaign := &Campaign{}
+	segment := campaign.AddElement(NewCampaignSegmentStep(352))
+
+	email := NewCampaignEmailStep(40)
+	email.FormID = 12
+	emailID := campaign.AddElement(email)
+
+	wait := NewCampaignWaitStep(time.Hour)
+	wait.WaitUntil = NewTimestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	waitID := campaign.AddElement(wait)
+
+	opened := NewCampaignEmailOpenedStep(40)
+	opened.EvaluateNoAfter = 86400
+	openedID := campaign.AddElement(opened)
+
+	campaign.Connect(segment, CampaignTerminalOut, emailID)
+	campaign.Connect(emailID, CampaignTerminalOut, waitID)
+	campaign.Connect(waitID, CampaignTerminalOut, openedID)
+
+	var validationErr *CampaignValidationError
+	if err := campaign.Validate(); !errors.As(err, &validationErr) {
+		t.Fatalf("Campaign.Validate expected a *CampaignValidationError, Recieved: %v", err)
+	}
+
+	want := []CampaignProblem{
+		{ElementID: -2, Message: "CampaignEmail step does not use formId"},
+		{ElementID: -3, Message: "wait step has both waitFor and waitUntil"},
+	}
+	testModels(t, "Campaign.Validate problems", validationErr.Problems, want)
+}
@@ -1,7 +1,11 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 // CampaignService provides access to all the endpoints related
@@ -15,18 +19,18 @@ type CampaignService struct {
 // Campaign represents an Eloqua campaign object.
 // Campaigns are often in other Eloqua models such as Emails & Landing Pages
 type Campaign struct {
-	Type          string   `json:"type,omitempty"`
-	CurrentStatus string   `json:"currentStatus,omitempty"`
-	ID            int      `json:"id,omitempty,string"`
-	CreatedAt     int      `json:"createdAt,omitempty,string"`
-	CreatedBy     int      `json:"createdBy,omitempty,string"`
-	Depth         string   `json:"depth,omitempty"`
-	Description   string   `json:"description,omitempty"`
-	FolderID      int      `json:"folderId,omitempty,string"`
-	Name          string   `json:"name,omitempty"`
-	Permissions   []string `json:"permissions,omitempty"`
-	UpdatedAt     int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy     int      `json:"updatedBy,omitempty,string"`
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	FolderID      int       `json:"folderId,omitempty,string"`
+	Name          string    `json:"name,omitempty"`
+	Permissions   []string  `json:"permissions,omitempty"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy     int       `json:"updatedBy,omitempty,string"`
 
 	Elements     []CampaignElement `json:"elements,omitempty"`
 	ActualCost   float32           `json:"actualCost,omitempty,string"`
@@ -34,23 +38,24 @@ type Campaign struct {
 	CampaignType string            `json:"campaignType,omitempty"`
 	FieldValues  []FieldValue      `json:"fieldValues,omitempty"`
 
-	IsEmailMarketingCampaign bool   `json:"isEmailMarketingCampaign,omitempty,string"`
-	IsMemberAllowedReEntry   bool   `json:"isMemberAllowedReEntry,omitempty,string"`
-	IsReadOnly               bool   `json:"isReadOnly,omitempty,string"`
-	IsIncludedInROI          bool   `json:"isIncludedInROI,omitempty,string"`
-	IsSyncedWithCRM          bool   `json:"isSyncedWithCRM,omitempty,string"`
-	RunAsUserID              int    `json:"runAsUserId,omitempty,string"`
-	EndAt                    int    `json:"endAt,omitempty,string"`
-	MemberCount              int    `json:"memberCount,omitempty,string"`
-	CRMId                    string `json:"crmId,omitempty"`
-	Product                  string `json:"product,omitempty"`
-	Region                   string `json:"region,omitempty"`
-	CampaignCategory         string `json:"campaignCategory,omitempty"`
-}
-
-// CampaignElement represents a generic Eloqua campaign step.
-// Steps do have their own action-specific properties but, for simplicity, only the common
-// properties are used below.
+	IsEmailMarketingCampaign bool      `json:"isEmailMarketingCampaign,omitempty,string"`
+	IsMemberAllowedReEntry   bool      `json:"isMemberAllowedReEntry,omitempty,string"`
+	IsReadOnly               bool      `json:"isReadOnly,omitempty,string"`
+	IsIncludedInROI          bool      `json:"isIncludedInROI,omitempty,string"`
+	IsSyncedWithCRM          bool      `json:"isSyncedWithCRM,omitempty,string"`
+	RunAsUserID              int       `json:"runAsUserId,omitempty,string"`
+	EndAt                    Timestamp `json:"endAt,omitempty"`
+	MemberCount              int       `json:"memberCount,omitempty,string"`
+	CRMId                    string    `json:"crmId,omitempty"`
+	Product                  string    `json:"product,omitempty"`
+	Region                   string    `json:"region,omitempty"`
+	CampaignCategory         string    `json:"campaignCategory,omitempty"`
+}
+
+// CampaignElement represents an Eloqua campaign step.
+// Type identifies the kind of step, Such as CampaignEmailStep or CampaignWaitStep,
+// and only the action-specific properties relevant to that kind are set.
+// See campaign_elements.go for constructors for each kind of step.
 type CampaignElement struct {
 	Type            string                   `json:"type,omitempty"`
 	ID              int                      `json:"id,omitempty,string"`
@@ -58,6 +63,17 @@ type CampaignElement struct {
 	MemberCount     int                      `json:"memberCount,omitempty,string"`
 	OutputTerminals []CampaignOutputTerminal `json:"outputTerminals,omitempty"`
 	Position        Position                 `json:"position,omitempty"`
+
+	SegmentID        int       `json:"segmentId,omitempty,string"`
+	EmailID          int       `json:"emailId,omitempty,string"`
+	FormID           int       `json:"formId,omitempty,string"`
+	LandingPageID    int       `json:"landingPageId,omitempty,string"`
+	ListID           int       `json:"listId,omitempty,string"`
+	FilterID         int       `json:"filterId,omitempty,string"`
+	ProgramElementID int       `json:"programElementId,omitempty,string"`
+	WaitFor          int       `json:"waitFor,omitempty,string"`
+	WaitUntil        Timestamp `json:"waitUntil,omitempty"`
+	EvaluateNoAfter  int       `json:"evaluateNoAfter,omitempty,string"`
 }
 
 // CampaignOutputTerminal represents the output flows of an element on a campaign.
@@ -71,34 +87,64 @@ type CampaignOutputTerminal struct {
 
 // Create a new campaign in eloqua
 func (e *CampaignService) Create(name string, campaign *Campaign) (*Campaign, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, campaign)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *CampaignService) CreateWithContext(ctx context.Context, name string, campaign *Campaign) (*Campaign, *Response, error) {
 	if campaign == nil {
 		campaign = &Campaign{}
 	}
 	campaign.Name = name
 
 	endpoint := "/assets/campaign"
-	resp, err := e.client.postRequestDecode(endpoint, campaign)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, campaign)
 	return campaign, resp, err
 }
 
 // Get an campaign object via its ID
 func (e *CampaignService) Get(id int) (*Campaign, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *CampaignService) GetWithContext(ctx context.Context, id int) (*Campaign, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/campaign/%d?depth=complete", id)
 	campaign := &Campaign{}
-	resp, err := e.client.getRequestDecode(endpoint, campaign)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, campaign)
 	return campaign, resp, err
 }
 
 // List many eloqua campaigns
 func (e *CampaignService) List(opts *ListOptions) ([]Campaign, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *CampaignService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Campaign, *Response, error) {
 	endpoint := "/assets/campaigns"
 	campaigns := new([]Campaign)
-	resp, err := e.client.getRequestListDecode(endpoint, campaigns, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, campaigns, opts)
 	return *campaigns, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua campaigns
+func (e *CampaignService) ListPager(opts *ListOptions) *Pager[Campaign] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua campaigns, Requesting each page in turn
+func (e *CampaignService) ListAll(ctx context.Context, opts *ListOptions) ([]Campaign, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing campaign in eloqua
 func (e *CampaignService) Update(id int, name string, campaign *Campaign) (*Campaign, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, campaign)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *CampaignService) UpdateWithContext(ctx context.Context, id int, name string, campaign *Campaign) (*Campaign, *Response, error) {
 	if campaign == nil {
 		campaign = &Campaign{}
 	}
@@ -107,14 +153,101 @@ func (e *CampaignService) Update(id int, name string, campaign *Campaign) (*Camp
 	campaign.Name = name
 
 	endpoint := fmt.Sprintf("/assets/campaign/%d", campaign.ID)
-	resp, err := e.client.putRequestDecode(endpoint, campaign)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, campaign)
 	return campaign, resp, err
 }
 
 // Delete an existing campaign from eloqua
 func (e *CampaignService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *CampaignService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	campaign := &Campaign{ID: id}
 	endpoint := fmt.Sprintf("/assets/campaign/%d", campaign.ID)
-	resp, err := e.client.deleteRequest(endpoint, campaign)
+	resp, err := e.client.deleteRequest(ctx, endpoint, campaign)
 	return resp, err
 }
+
+// CampaignActivateOptions specifies the optional parameters when activating a campaign
+type CampaignActivateOptions struct {
+	// Activate the campaign immediately rather than at its scheduled time
+	ActivateNow bool `url:"activateNow,omitempty"`
+	// The time the campaign should start running
+	ScheduledFor Timestamp `url:"scheduledFor,omitempty"`
+	// The ID of the user the campaign runs as, Whose permissions are used to send emails
+	RunAsUserID int `url:"runAsUserId,omitempty"`
+}
+
+// Activate an existing campaign in eloqua, Either immediately or at a scheduled time.
+// The returned campaign's CurrentStatus reflects the new state, Such as "Active" or "Scheduled".
+func (e *CampaignService) Activate(id int, opts *CampaignActivateOptions) (*Campaign, *Response, error) {
+	return e.ActivateWithContext(context.Background(), id, opts)
+}
+
+// ActivateWithContext is like Activate but performs the request with the given context.
+func (e *CampaignService) ActivateWithContext(ctx context.Context, id int, opts *CampaignActivateOptions) (*Campaign, *Response, error) {
+	if opts == nil {
+		opts = &CampaignActivateOptions{}
+	}
+
+	encoder, _ := query.Values(opts)
+	endpoint := fmt.Sprintf("/assets/campaign/active/%d", id)
+	if q := encoder.Encode(); q != "" {
+		endpoint += "?" + q
+	}
+
+	campaign := &Campaign{}
+	resp, err := e.client.requestDecodeInto(ctx, endpoint, "POST", nil, campaign)
+	return campaign, resp, err
+}
+
+// Schedule an existing campaign in eloqua to run from start until end.
+// The campaign's end date is updated before it is activated, A zero end leaves the end date unchanged.
+// A runAsUserID of zero uses Eloqua's default user.
+func (e *CampaignService) Schedule(id int, start time.Time, end time.Time, runAsUserID int) (*Campaign, *Response, error) {
+	return e.ScheduleWithContext(context.Background(), id, start, end, runAsUserID)
+}
+
+// ScheduleWithContext is like Schedule but performs the requests with the given context.
+func (e *CampaignService) ScheduleWithContext(ctx context.Context, id int, start time.Time, end time.Time, runAsUserID int) (*Campaign, *Response, error) {
+	if start.IsZero() {
+		return nil, nil, fmt.Errorf("Campaign start time is required to schedule")
+	}
+
+	if !end.IsZero() {
+		if !end.After(start) {
+			return nil, nil, fmt.Errorf("Campaign end time must be after its start time")
+		}
+
+		campaign, resp, err := e.GetWithContext(ctx, id)
+		if err != nil {
+			return campaign, resp, err
+		}
+
+		campaign.EndAt = NewTimestamp(end)
+		campaign, resp, err = e.UpdateWithContext(ctx, id, campaign.Name, campaign)
+		if err != nil {
+			return campaign, resp, err
+		}
+	}
+
+	return e.ActivateWithContext(ctx, id, &CampaignActivateOptions{
+		ScheduledFor: NewTimestamp(start),
+		RunAsUserID:  runAsUserID,
+	})
+}
+
+// Deactivate an active or scheduled campaign in eloqua, Returning it to draft
+func (e *CampaignService) Deactivate(id int) (*Campaign, *Response, error) {
+	return e.DeactivateWithContext(context.Background(), id)
+}
+
+// DeactivateWithContext is like Deactivate but performs the request with the given context.
+func (e *CampaignService) DeactivateWithContext(ctx context.Context, id int) (*Campaign, *Response, error) {
+	endpoint := fmt.Sprintf("/assets/campaign/draft/%d", id)
+	campaign := &Campaign{}
+	resp, err := e.client.requestDecodeInto(ctx, endpoint, "POST", nil, campaign)
+	return campaign, resp, err
+}
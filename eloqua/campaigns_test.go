@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestCampaignCreate(t *testing.T) {
@@ -56,7 +57,8 @@ func TestCampaignGet(t *testing.T) {
 			OutputTerminals: []CampaignOutputTerminal{
 				CampaignOutputTerminal{Type: "CampaignOutputTerminal", ID: 4430, ConnectedID: 4441, ConnectedType: "CampaignEmail", TerminalType: "out"},
 			},
-			Position: Position{Type: "Position", X: 382, Y: 136},
+			Position:  Position{Type: "Position", X: 382, Y: 136},
+			SegmentID: 352,
 		},
 	}}
 	testModels(t, "Campaigns.Get", campaign, output)
@@ -172,3 +174,82 @@ func TestCampaignDelete(t *testing.T) {
 		t.Error("Campaigns.Delete request failed")
 	}
 }
+
+func TestCampaignActivate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/campaign/active/10005", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		testURLParam(t, req, "activateNow", "true")
+		testURLParam(t, req, "runAsUserId", "12")
+		fmt.Fprint(w, `{"type":"Campaign","id":"10005","name":"A Test Campaign","currentStatus":"Active"}`)
+	})
+
+	campaign, _, err := client.Campaigns.Activate(10005, &CampaignActivateOptions{ActivateNow: true, RunAsUserID: 12})
+	if err != nil {
+		t.Fatalf("Campaigns.Activate recieved error: %v", err)
+	}
+
+	output := &Campaign{Type: "Campaign", ID: 10005, Name: "A Test Campaign", CurrentStatus: "Active"}
+	testModels(t, "Campaigns.Activate", campaign, output)
+}
+
+func TestCampaignSchedule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Unix(1700000000, 0)
+	end := time.Unix(1710000000, 0)
+
+	addRestHandlerFunc("/assets/campaign/10005", func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			fmt.Fprint(w, `{"type":"Campaign","id":"10005","name":"A Test Campaign","currentStatus":"Draft"}`)
+			return
+		}
+
+		testMethod(t, req, "PUT")
+		v := new(Campaign)
+		json.NewDecoder(req.Body).Decode(v)
+		testModels(t, "Campaigns.Schedule update body", v, &Campaign{Type: "Campaign", ID: 10005, Name: "A Test Campaign", CurrentStatus: "Draft", EndAt: 1710000000})
+		json.NewEncoder(w).Encode(v)
+	})
+
+	addRestHandlerFunc("/assets/campaign/active/10005", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		testURLParam(t, req, "scheduledFor", "1700000000")
+		testURLParam(t, req, "activateNow", "")
+		fmt.Fprint(w, `{"type":"Campaign","id":"10005","name":"A Test Campaign","currentStatus":"Scheduled","endAt":"1710000000"}`)
+	})
+
+	campaign, _, err := client.Campaigns.Schedule(10005, start, end, 0)
+	if err != nil {
+		t.Fatalf("Campaigns.Schedule recieved error: %v", err)
+	}
+
+	output := &Campaign{Type: "Campaign", ID: 10005, Name: "A Test Campaign", CurrentStatus: "Scheduled", EndAt: 1710000000}
+	testModels(t, "Campaigns.Schedule", campaign, output)
+
+	if _, _, err := client.Campaigns.Schedule(10005, end, start, 0); err == nil {
+		t.Error("Campaigns.Schedule expected error for an end before the start")
+	}
+}
+
+func TestCampaignDeactivate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/campaign/draft/10005", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		fmt.Fprint(w, `{"type":"Campaign","id":"10005","name":"A Test Campaign","currentStatus":"Draft"}`)
+	})
+
+	campaign, _, err := client.Campaigns.Deactivate(10005)
+	if err != nil {
+		t.Fatalf("Campaigns.Deactivate recieved error: %v", err)
+	}
+
+	if campaign.CurrentStatus != "Draft" {
+		t.Errorf("Campaigns.Deactivate status not as expected, Recieved: %s", campaign.CurrentStatus)
+	}
+}
@@ -0,0 +1,238 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Changes is a set of field-level changes to a contact or account, Used to update
+// only the fields that have been explicitly set, Including fields set to empty values.
+// Changes are created with NewContactChanges & NewAccountChanges, Or by comparing
+// an original & updated model with ContactChanges & AccountChanges.
+type Changes struct {
+	model       reflect.Type
+	fields      map[string]interface{}
+	fieldValues map[int]string
+	err         error
+}
+
+// readOnlyChangeFields are model fields that are never sent as changes.
+var readOnlyChangeFields = map[string]bool{
+	"type":          true,
+	"id":            true,
+	"depth":         true,
+	"currentStatus": true,
+	"createdAt":     true,
+	"createdBy":     true,
+	"updatedAt":     true,
+	"updatedBy":     true,
+	"fieldValues":   true,
+}
+
+// changeField describes a model field that can be changed.
+type changeField struct {
+	index  int
+	quoted bool
+}
+
+// changeFields returns the changeable fields of a model type, Keyed by their json names.
+func changeFields(model reflect.Type) map[string]changeField {
+	fields := map[string]changeField{}
+	for i := 0; i < model.NumField(); i++ {
+		tag := strings.Split(model.Field(i).Tag.Get("json"), ",")
+		if tag[0] == "" || tag[0] == "-" || readOnlyChangeFields[tag[0]] {
+			continue
+		}
+		quoted := false
+		for _, opt := range tag[1:] {
+			quoted = quoted || opt == "string"
+		}
+		fields[tag[0]] = changeField{index: i, quoted: quoted}
+	}
+	return fields
+}
+
+// newChanges creates an empty set of changes to the given model type.
+func newChanges(model reflect.Type) *Changes {
+	return &Changes{model: model, fields: map[string]interface{}{}, fieldValues: map[int]string{}}
+}
+
+// NewContactChanges creates an empty set of changes to a contact.
+func NewContactChanges() *Changes {
+	return newChanges(reflect.TypeOf(Contact{}))
+}
+
+// NewAccountChanges creates an empty set of changes to an account.
+func NewAccountChanges() *Changes {
+	return newChanges(reflect.TypeOf(Account{}))
+}
+
+// Set changes the field with the given json name, Such as "firstName", to value.
+// Empty values are sent, Clearing the field in Eloqua.
+// Setting a field that is not part of the model, Or is read-only, causes the update to fail.
+func (c *Changes) Set(field string, value interface{}) *Changes {
+	f, ok := changeFields(c.model)[field]
+	if !ok {
+		if c.err == nil {
+			c.err = fmt.Errorf("%s is not a changeable %s field", field, c.model.Name())
+		}
+		return c
+	}
+
+	c.fields[field] = changeValue(value, f.quoted)
+	return c
+}
+
+// Clear changes the field with the given json name to an empty value.
+func (c *Changes) Clear(field string) *Changes {
+	return c.Set(field, "")
+}
+
+// SetFieldValue changes the custom field with the given ID to value.
+func (c *Changes) SetFieldValue(id int, value string) *Changes {
+	c.fieldValues[id] = value
+	return c
+}
+
+// ClearFieldValue changes the custom field with the given ID to an empty value.
+func (c *Changes) ClearFieldValue(id int) *Changes {
+	return c.SetFieldValue(id, "")
+}
+
+// Changed returns the sorted json names of the changed fields.
+// Changed custom fields are named "fieldValues[ID]".
+func (c *Changes) Changed() []string {
+	changed := make([]string, 0, len(c.fields)+len(c.fieldValues))
+	for field := range c.fields {
+		changed = append(changed, field)
+	}
+	for id := range c.fieldValues {
+		changed = append(changed, fmt.Sprintf("fieldValues[%d]", id))
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// IsEmpty reports whether there are no changes.
+func (c *Changes) IsEmpty() bool {
+	return len(c.fields) == 0 && len(c.fieldValues) == 0
+}
+
+// Err returns the first error from setting the changes.
+func (c *Changes) Err() error {
+	return c.err
+}
+
+// check returns an error if the changes are not to the given model type or could not be set.
+func (c *Changes) check(model reflect.Type) error {
+	if c == nil {
+		return errors.New("No changes given")
+	}
+	if c.model != model {
+		return fmt.Errorf("Changes to a %s cannot be applied to a %s", c.model.Name(), model.Name())
+	}
+	return c.err
+}
+
+// body returns the request body for the changes to the entity with the given ID.
+func (c *Changes) body(id int) map[string]interface{} {
+	body := map[string]interface{}{"id": strconv.Itoa(id)}
+	for field, value := range c.fields {
+		body[field] = value
+	}
+
+	if len(c.fieldValues) > 0 {
+		ids := make([]int, 0, len(c.fieldValues))
+		for fieldID := range c.fieldValues {
+			ids = append(ids, fieldID)
+		}
+		sort.Ints(ids)
+
+		fieldValues := make([]map[string]string, len(ids))
+		for i, fieldID := range ids {
+			fieldValues[i] = map[string]string{"type": "FieldValue", "id": strconv.Itoa(fieldID), "value": c.fieldValues[fieldID]}
+		}
+		body["fieldValues"] = fieldValues
+	}
+
+	return body
+}
+
+// bodyWith returns the request body for the changes with a field Eloqua requires set to value.
+// An error is returned if the changes already set the field to a different value.
+func (c *Changes) bodyWith(id int, field string, value string) (map[string]interface{}, error) {
+	body := c.body(id)
+	if changed, ok := body[field]; ok && changed != value {
+		return nil, fmt.Errorf("Changes set %s to %q but %q was given", field, changed, value)
+	}
+	body[field] = value
+	return body, nil
+}
+
+// MarshalJSON encodes only the changed fields.
+func (c *Changes) MarshalJSON() ([]byte, error) {
+	body := c.body(0)
+	delete(body, "id")
+	return json.Marshal(body)
+}
+
+// changeValue converts a value to the representation Eloqua expects,
+// Quoting ints & bools for fields that are sent as strings.
+func changeValue(value interface{}, quoted bool) interface{} {
+	if !quoted {
+		return value
+	}
+	switch v := value.(type) {
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return value
+}
+
+// diffChanges adds a change for every changeable field that differs between original & updated.
+func diffChanges(changes *Changes, original reflect.Value, updated reflect.Value) *Changes {
+	for name, f := range changeFields(changes.model) {
+		value := updated.Field(f.index).Interface()
+		if !reflect.DeepEqual(original.Field(f.index).Interface(), value) {
+			changes.fields[name] = changeValue(value, f.quoted)
+		}
+	}
+	return changes
+}
+
+// diffFieldValues adds a change for every custom field value in updated that differs from original.
+// Custom fields missing from updated are left unchanged.
+func diffFieldValues(changes *Changes, original []FieldValue, updated []FieldValue) {
+	existing := map[int]string{}
+	for _, fv := range original {
+		existing[fv.ID] = fv.Value
+	}
+	for _, fv := range updated {
+		if value, ok := existing[fv.ID]; !ok || value != fv.Value {
+			changes.fieldValues[fv.ID] = fv.Value
+		}
+	}
+}
+
+// ContactChanges compares an original contact, As retrieved from Eloqua, with an
+// updated copy and returns the fields that have changed.
+func ContactChanges(original *Contact, updated *Contact) *Changes {
+	changes := diffChanges(NewContactChanges(), reflect.ValueOf(*original), reflect.ValueOf(*updated))
+	diffFieldValues(changes, original.FieldValues, updated.FieldValues)
+	return changes
+}
+
+// AccountChanges compares an original account, As retrieved from Eloqua, with an
+// updated copy and returns the fields that have changed.
+func AccountChanges(original *Account, updated *Account) *Changes {
+	changes := diffChanges(NewAccountChanges(), reflect.ValueOf(*original), reflect.ValueOf(*updated))
+	diffFieldValues(changes, original.FieldValues, updated.FieldValues)
+	return changes
+}
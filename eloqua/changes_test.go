@@ -0,0 +1,162 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestContactUpdateChanges(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/5", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "PUT")
+		body := map[string]interface{}{}
+		json.NewDecoder(req.Body).Decode(&body)
+
+		want := map[string]interface{}{
+			"id":            "5",
+			"emailAddress":  "jane@example.com",
+			"firstName":     "Jane",
+			"businessPhone": "",
+			"isSubscribed":  "false",
+			"fieldValues": []interface{}{
+				map[string]interface{}{"type": "FieldValue", "id": "100", "value": ""},
+				map[string]interface{}{"type": "FieldValue", "id": "101", "value": "Gold"},
+			},
+		}
+		testModels(t, "Contacts.UpdateChanges body", body, want)
+
+		fmt.Fprint(w, `{"type":"Contact","id":"5","emailAddress":"jane@example.com","firstName":"Jane"}`)
+	})
+
+	changes := NewContactChanges().
+		Set("firstName", "Jane").
+		Clear("businessPhone").
+		Set("isSubscribed", false).
+		SetFieldValue(101, "Gold").
+		ClearFieldValue(100)
+
+	testModels(t, "Changes.Changed", changes.Changed(), []string{"businessPhone", "fieldValues[100]", "fieldValues[101]", "firstName", "isSubscribed"})
+
+	contact, _, err := client.Contacts.UpdateChanges(5, "jane@example.com", changes)
+	if err != nil {
+		t.Fatalf("Contacts.UpdateChanges recieved error: %v", err)
+	}
+
+	want := &Contact{Type: "Contact", ID: 5, EmailAddress: "jane@example.com", FirstName: "Jane"}
+	testModels(t, "Contacts.UpdateChanges", contact, want)
+}
+
+func TestAccountUpdateChanges(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/account/8", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "PUT")
+		body := map[string]interface{}{}
+		json.NewDecoder(req.Body).Decode(&body)
+		testModels(t, "Accounts.UpdateChanges body", body, map[string]interface{}{"id": "8", "name": "Acme", "city": "London", "address2": ""})
+		fmt.Fprint(w, `{"type":"Account","id":"8","name":"Acme","city":"London"}`)
+	})
+
+	original := &Account{ID: 8, Name: "Acme", City: "Leeds", Address2: "Unit 4", UpdatedAt: 1420070400}
+	updated := *original
+	updated.City = "London"
+	updated.Address2 = ""
+	updated.UpdatedAt = 1420070460
+
+	account, _, err := client.Accounts.UpdateChanges(8, "Acme", AccountChanges(original, &updated))
+	if err != nil {
+		t.Fatalf("Accounts.UpdateChanges recieved error: %v", err)
+	}
+	if account.City != "London" {
+		t.Errorf("Accounts.UpdateChanges response not decoded, Recieved: %+v", account)
+	}
+}
+
+func TestContactChangesDiff(t *testing.T) {
+	original := &Contact{
+		ID:           5,
+		EmailAddress: "jane@example.com",
+		Title:        "Manager",
+		IsSubscribed: true,
+		FieldValues:  []FieldValue{{ID: 100, Value: "Silver"}, {ID: 101, Value: "UK"}},
+	}
+
+	updated := *original
+	updated.Title = ""
+	updated.FirstName = "Jane"
+	updated.FieldValues = []FieldValue{{ID: 100, Value: "Gold"}, {ID: 101, Value: "UK"}, {ID: 102, Value: ""}}
+
+	changes := ContactChanges(original, &updated)
+	testModels(t, "ContactChanges", changes.Changed(), []string{"fieldValues[100]", "fieldValues[102]", "firstName", "title"})
+
+	content, _ := json.Marshal(changes)
+	want := `{"fieldValues":[{"id":"100","type":"FieldValue","value":"Gold"},{"id":"102","type":"FieldValue","value":""}],"firstName":"Jane","title":""}`
+	if string(content) != want {
+		t.Errorf("Changes JSON not as expected, Recieved: %s", content)
+	}
+
+	if !ContactChanges(original, original).IsEmpty() {
+		t.Error("Expected no changes between identical contacts")
+	}
+}
+
+func TestUpdateChangesErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/5", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("Request should not be sent for invalid changes")
+	})
+
+	tests := map[string]*Changes{
+		"unknown field":   NewContactChanges().Set("favouriteColour", "Blue"),
+		"read-only field": NewContactChanges().Set("createdAt", 1420070400),
+		"account changes": NewAccountChanges().Set("city", "London"),
+		"nil changes":     nil,
+	}
+
+	for name, changes := range tests {
+		if _, _, err := client.Contacts.UpdateChanges(5, "jane@example.com", changes); err == nil {
+			t.Errorf("Expected an error updating a contact with %s", name)
+		}
+	}
+}
+
+func TestUpdateChangesRequiredFieldConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/5", func(w http.ResponseWriter, req *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["emailAddress"] != "new@example.com" {
+			t.Errorf("Expected the changed email address to be sent, Recieved: %v", body["emailAddress"])
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"5","emailAddress":"new@example.com"}`)
+	})
+	addRestHandlerFunc("/data/account/8", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("Request should not be sent when the name conflicts with the changes")
+	})
+
+	original := &Contact{ID: 5, EmailAddress: "old@example.com"}
+	updated := *original
+	updated.EmailAddress = "new@example.com"
+	changes := ContactChanges(original, &updated)
+
+	if _, _, err := client.Contacts.UpdateChanges(5, "old@example.com", changes); err == nil {
+		t.Error("Expected an error when the email address conflicts with the changes")
+	}
+	if _, _, err := client.Contacts.UpdateChanges(5, "new@example.com", changes); err != nil {
+		t.Errorf("Contacts.UpdateChanges recieved error: %v", err)
+	}
+
+	accountChanges := NewAccountChanges().Set("name", "Acme Ltd")
+	if _, _, err := client.Accounts.UpdateChanges(8, "Acme", accountChanges); err == nil {
+		t.Error("Expected an error when the name conflicts with the changes")
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,12 +17,12 @@ type ContactFieldService struct {
 // Fields that are not listed in the ContactField model itself can be retrieved/updated
 // using the 'FieldValues' property.
 type ContactField struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-	Name      string `json:"name,omitempty"`
-	UpdatedAt int    `json:"updatedAt,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	Depth     string    `json:"depth,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updatedAt,omitempty"`
 
 	DataType     string `json:"dataType,omitempty"`
 	DisplayType  string `json:"displayType,omitempty"`
@@ -37,6 +38,11 @@ type ContactField struct {
 
 // Create a new contact field in eloqua
 func (e *ContactFieldService) Create(name string, dataType string, displayType string, updateType string, contactField *ContactField) (*ContactField, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, dataType, displayType, updateType, contactField)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ContactFieldService) CreateWithContext(ctx context.Context, name string, dataType string, displayType string, updateType string, contactField *ContactField) (*ContactField, *Response, error) {
 	if contactField == nil {
 		contactField = &ContactField{}
 	}
@@ -49,28 +55,53 @@ func (e *ContactFieldService) Create(name string, dataType string, displayType s
 	contactField.IsProtected = false
 
 	endpoint := "/assets/contact/field"
-	resp, err := e.client.postRequestDecode(endpoint, contactField)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, contactField)
 	return contactField, resp, err
 }
 
 // Get an contact field object via its ID
 func (e *ContactFieldService) Get(id int) (*ContactField, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ContactFieldService) GetWithContext(ctx context.Context, id int) (*ContactField, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/contact/field/%d?depth=complete", id)
 	contactField := &ContactField{}
-	resp, err := e.client.getRequestDecode(endpoint, contactField)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, contactField)
 	return contactField, resp, err
 }
 
 // List many eloqua contact fields
 func (e *ContactFieldService) List(opts *ListOptions) ([]ContactField, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ContactFieldService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ContactField, *Response, error) {
 	endpoint := "/assets/contact/fields"
 	contactFields := new([]ContactField)
-	resp, err := e.client.getRequestListDecode(endpoint, contactFields, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contactFields, opts)
 	return *contactFields, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua contact fields
+func (e *ContactFieldService) ListPager(opts *ListOptions) *Pager[ContactField] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua contact fields, Requesting each page in turn
+func (e *ContactFieldService) ListAll(ctx context.Context, opts *ListOptions) ([]ContactField, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing contact field in eloqua
 func (e *ContactFieldService) Update(id int, name string, dataType string, displayType string, updateType string, contactField *ContactField) (*ContactField, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, dataType, displayType, updateType, contactField)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ContactFieldService) UpdateWithContext(ctx context.Context, id int, name string, dataType string, displayType string, updateType string, contactField *ContactField) (*ContactField, *Response, error) {
 	if contactField == nil {
 		contactField = &ContactField{}
 	}
@@ -82,14 +113,19 @@ func (e *ContactFieldService) Update(id int, name string, dataType string, displ
 	contactField.UpdateType = updateType
 
 	endpoint := fmt.Sprintf("/assets/contact/field/%d", contactField.ID)
-	resp, err := e.client.putRequestDecode(endpoint, contactField)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, contactField)
 	return contactField, resp, err
 }
 
 // Delete an existing contact field from eloqua
 func (e *ContactFieldService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ContactFieldService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	contactField := &ContactField{ID: id}
 	endpoint := fmt.Sprintf("/assets/contact/field/%d", contactField.ID)
-	resp, err := e.client.deleteRequest(endpoint, contactField)
+	resp, err := e.client.deleteRequest(ctx, endpoint, contactField)
 	return resp, err
 }
@@ -1,7 +1,9 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 )
 
 // ContactListService provides access to all the endpoints related
@@ -14,18 +16,18 @@ type ContactListService struct {
 
 // ContactList represents an Eloqua contact list object.
 type ContactList struct {
-	Type         string   `json:"type,omitempty"`
-	ID           int      `json:"id,omitempty,string"`
-	CreatedAt    int      `json:"createdAt,omitempty,string"`
-	Depth        string   `json:"depth,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Description  string   `json:"description,omitempty"`
-	UpdatedAt    int      `json:"updatedAt,omitempty,string"`
-	FolderID     int      `json:"folderId,omitempty,string"`
-	Permissions  []string `json:"permissions,omitempty"`
-	Count        int      `json:"count,omitempty,string"`
-	DataLookupID string   `json:"dataLookupId,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	ID           int       `json:"id,omitempty,string"`
+	CreatedAt    Timestamp `json:"createdAt,omitempty"`
+	Depth        string    `json:"depth,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	UpdatedAt    Timestamp `json:"updatedAt,omitempty"`
+	FolderID     int       `json:"folderId,omitempty,string"`
+	Permissions  []string  `json:"permissions,omitempty"`
+	Count        int       `json:"count,omitempty,string"`
+	DataLookupID string    `json:"dataLookupId,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
 
 	// Used to add contact ID's to to add or delete from a list
 	// Writeonly, Not listed in official Eloqua developer documents
@@ -33,36 +35,70 @@ type ContactList struct {
 	MembershipDeletions []int `json:"membershipDeletions,omitempty,string"`
 }
 
+// contactListMembershipChunkSize is the maximum number of contacts
+// added or removed from a list in a single request.
+const contactListMembershipChunkSize = 1000
+
 // Create a new contact list in eloqua
 func (e *ContactListService) Create(name string, contactList *ContactList) (*ContactList, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, contactList)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ContactListService) CreateWithContext(ctx context.Context, name string, contactList *ContactList) (*ContactList, *Response, error) {
 	if contactList == nil {
 		contactList = &ContactList{}
 	}
 
 	contactList.Name = name
 	endpoint := "/assets/contact/list"
-	resp, err := e.client.postRequestDecode(endpoint, contactList)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, contactList)
 	return contactList, resp, err
 }
 
 // Get a contact list object via its ID
 func (e *ContactListService) Get(id int) (*ContactList, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ContactListService) GetWithContext(ctx context.Context, id int) (*ContactList, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/contact/list/%d?depth=complete", id)
 	contactList := &ContactList{}
-	resp, err := e.client.getRequestDecode(endpoint, contactList)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, contactList)
 	return contactList, resp, err
 }
 
 // List many eloqua contact lists
 func (e *ContactListService) List(opts *ListOptions) ([]ContactList, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ContactListService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ContactList, *Response, error) {
 	endpoint := "/assets/contact/lists"
 	contactLists := new([]ContactList)
-	resp, err := e.client.getRequestListDecode(endpoint, contactLists, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contactLists, opts)
 	return *contactLists, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua contact lists
+func (e *ContactListService) ListPager(opts *ListOptions) *Pager[ContactList] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua contact lists, Requesting each page in turn
+func (e *ContactListService) ListAll(ctx context.Context, opts *ListOptions) ([]ContactList, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing contact list in eloqua
 func (e *ContactListService) Update(id int, name string, contactList *ContactList) (*ContactList, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, contactList)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ContactListService) UpdateWithContext(ctx context.Context, id int, name string, contactList *ContactList) (*ContactList, *Response, error) {
 	if contactList == nil {
 		contactList = &ContactList{}
 	}
@@ -71,14 +107,108 @@ func (e *ContactListService) Update(id int, name string, contactList *ContactLis
 	contactList.Name = name
 
 	endpoint := fmt.Sprintf("/assets/contact/list/%d", contactList.ID)
-	resp, err := e.client.putRequestDecode(endpoint, contactList)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, contactList)
 	return contactList, resp, err
 }
 
 // Delete an existing contact list from eloqua
 func (e *ContactListService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ContactListService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	contactList := &ContactList{ID: id}
 	endpoint := fmt.Sprintf("/assets/contact/list/%d", contactList.ID)
-	resp, err := e.client.deleteRequest(endpoint, contactList)
+	resp, err := e.client.deleteRequest(ctx, endpoint, contactList)
 	return resp, err
 }
+
+// ListContacts lists the contacts that are members of the contact list with the given ID
+func (e *ContactListService) ListContacts(id int, opts *ListOptions) ([]Contact, *Response, error) {
+	return e.ListContactsWithContext(context.Background(), id, opts)
+}
+
+// ListContactsWithContext is like ListContacts but performs the request with the given context.
+func (e *ContactListService) ListContactsWithContext(ctx context.Context, id int, opts *ListOptions) ([]Contact, *Response, error) {
+	endpoint := fmt.Sprintf("/data/contacts/list/%d", id)
+	contacts := new([]Contact)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contacts, opts)
+	return *contacts, resp, err
+}
+
+// ListContactsPager returns a Pager to iterate over every page of contacts in the contact list with the given ID
+func (e *ContactListService) ListContactsPager(id int, opts *ListOptions) *Pager[Contact] {
+	return newPager(opts, func(ctx context.Context, opts *ListOptions) ([]Contact, *Response, error) {
+		return e.ListContactsWithContext(ctx, id, opts)
+	})
+}
+
+// ListAllContacts lists every contact in the contact list with the given ID, Requesting each page in turn
+func (e *ContactListService) ListAllContacts(ctx context.Context, id int, opts *ListOptions) ([]Contact, error) {
+	return e.ListContactsPager(id, opts).All(ctx)
+}
+
+// AddContacts adds the contacts with the given IDs to the contact list with the given ID.
+// Contacts are added in chunks to keep requests within Eloqua's size limits.
+// The number of contacts added is returned, Which is less than requested if a chunk fails.
+func (e *ContactListService) AddContacts(id int, contactIDs []int) (int, *Response, error) {
+	return e.AddContactsWithContext(context.Background(), id, contactIDs)
+}
+
+// AddContactsWithContext is like AddContacts but performs the requests with the given context.
+func (e *ContactListService) AddContactsWithContext(ctx context.Context, id int, contactIDs []int) (int, *Response, error) {
+	return e.updateMembership(ctx, id, "membershipAdditions", contactIDs)
+}
+
+// RemoveContacts removes the contacts with the given IDs from the contact list with the given ID.
+// Contacts are removed in chunks to keep requests within Eloqua's size limits.
+// The number of contacts removed is returned, Which is less than requested if a chunk fails.
+func (e *ContactListService) RemoveContacts(id int, contactIDs []int) (int, *Response, error) {
+	return e.RemoveContactsWithContext(context.Background(), id, contactIDs)
+}
+
+// RemoveContactsWithContext is like RemoveContacts but performs the requests with the given context.
+func (e *ContactListService) RemoveContactsWithContext(ctx context.Context, id int, contactIDs []int) (int, *Response, error) {
+	return e.updateMembership(ctx, id, "membershipDeletions", contactIDs)
+}
+
+// updateMembership adds or removes contacts from a list in chunks.
+// The list's name is required by Eloqua when updating so the list is requested first.
+func (e *ContactListService) updateMembership(ctx context.Context, id int, property string, contactIDs []int) (int, *Response, error) {
+	if len(contactIDs) == 0 {
+		return 0, nil, nil
+	}
+
+	contactList, resp, err := e.GetWithContext(ctx, id)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	endpoint := fmt.Sprintf("/assets/contact/list/%d", id)
+	applied := 0
+	for start := 0; start < len(contactIDs); start += contactListMembershipChunkSize {
+		end := start + contactListMembershipChunkSize
+		if end > len(contactIDs) {
+			end = len(contactIDs)
+		}
+
+		ids := make([]string, end-start)
+		for i, contactID := range contactIDs[start:end] {
+			ids[i] = strconv.Itoa(contactID)
+		}
+
+		body := map[string]interface{}{
+			"id":     strconv.Itoa(id),
+			"name":   contactList.Name,
+			property: ids,
+		}
+		resp, err = e.client.requestDecodeInto(ctx, endpoint, "PUT", body, nil)
+		if err != nil {
+			return applied, resp, err
+		}
+		applied = end
+	}
+
+	return applied, resp, nil
+}
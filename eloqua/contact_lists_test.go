@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -156,3 +157,104 @@ func TestContactListDelete(t *testing.T) {
 		t.Error("ContactLists.Delete request failed")
 	}
 }
+
+func TestContactListAddContacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	chunks := [][]string{}
+	addRestHandlerFunc("/assets/contact/list/55", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			fmt.Fprint(w, `{"type":"ContactList","id":"55","name":"Newsletter"}`)
+		case "PUT":
+			body := struct {
+				ID        string   `json:"id"`
+				Name      string   `json:"name"`
+				Additions []string `json:"membershipAdditions"`
+			}{}
+			json.NewDecoder(req.Body).Decode(&body)
+			if body.ID != "55" || body.Name != "Newsletter" {
+				t.Errorf("Membership request body not as expected, Recieved: %+v", body)
+			}
+			chunks = append(chunks, body.Additions)
+			fmt.Fprint(w, `{"type":"ContactList","id":"55","name":"Newsletter"}`)
+		}
+	})
+
+	contactIDs := make([]int, 2500)
+	for i := range contactIDs {
+		contactIDs[i] = i + 1
+	}
+
+	added, _, err := client.ContactLists.AddContacts(55, contactIDs)
+	if err != nil {
+		t.Fatalf("ContactLists.AddContacts recieved error: %v", err)
+	}
+	if added != 2500 {
+		t.Errorf("Expected 2500 contacts to be added, Recieved %d", added)
+	}
+	if len(chunks) != 3 || len(chunks[0]) != 1000 || len(chunks[2]) != 500 || chunks[2][499] != "2500" {
+		t.Errorf("Expected contacts to be added in chunks of 1000, Recieved %d chunks", len(chunks))
+	}
+
+	if added, resp, err := client.ContactLists.AddContacts(55, nil); added != 0 || resp != nil || err != nil {
+		t.Error("Expected no requests when adding no contacts")
+	}
+}
+
+func TestContactListRemoveContactsError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	puts := 0
+	addRestHandlerFunc("/assets/contact/list/55", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			fmt.Fprint(w, `{"type":"ContactList","id":"55","name":"Newsletter"}`)
+		case "PUT":
+			body := map[string]interface{}{}
+			json.NewDecoder(req.Body).Decode(&body)
+			if _, ok := body["membershipDeletions"]; !ok {
+				t.Errorf("Expected membership deletions, Recieved: %+v", body)
+			}
+			puts++
+			if puts == 2 {
+				w.WriteHeader(500)
+				return
+			}
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	removed, _, err := client.ContactLists.RemoveContacts(55, make([]int, 1500))
+	if err == nil {
+		t.Error("Expected an error from the failed chunk")
+	}
+	if removed != 1000 {
+		t.Errorf("Expected the first chunk to be reported as removed, Recieved %d", removed)
+	}
+}
+
+func TestContactListContacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts/list/55", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		if req.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"1"},{"type":"Contact","id":"2"}],"page":1,"pageSize":2,"total":3}`)
+		} else {
+			testURLParam(t, req, "page", "2")
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"3"}],"page":2,"pageSize":2,"total":3}`)
+		}
+	})
+
+	contacts, err := client.ContactLists.ListAllContacts(context.Background(), 55, &ListOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("ContactLists.ListAllContacts recieved error: %v", err)
+	}
+	if len(contacts) != 3 || contacts[2].ID != 3 {
+		t.Errorf("ContactLists.ListAllContacts not as expected, Recieved: %+v", contacts)
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,54 +15,175 @@ type ContactSegmentService struct {
 
 // ContactSegment represents an Eloqua contact segment object.
 type ContactSegment struct {
-	Type          string `json:"type,omitempty"`
-	CurrentStatus string `json:"currentStatus,omitempty"`
-	ID            int    `json:"id,omitempty,string"`
-	CreatedAt     int    `json:"createdAt,omitempty,string"`
-	CreatedBy     int    `json:"createdBy,omitempty,string"`
-	Depth         string `json:"depth,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
 
-	Name        string   `json:"name,omitempty"`
-	Description string   `json:"description,omitempty"`
-	FolderID    int      `json:"folderId,omitempty,string"`
-	UpdatedAt   int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy   int      `json:"updatedBy,omitempty,string"`
-	Permissions []string `json:"permissions,omitempty"`
-	Count       int      `json:"count,omitempty,string"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	FolderID    int       `json:"folderId,omitempty,string"`
+	UpdatedAt   Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy   int       `json:"updatedBy,omitempty,string"`
+	Permissions []string  `json:"permissions,omitempty"`
+	Count       int       `json:"count,omitempty,string"`
 
-	// Todo - Elements
+	Elements []SegmentElement `json:"elements,omitempty"`
+}
+
+// Segment element types
+const (
+	ContactListSegmentElement   = "ContactListSegmentElement"
+	ContactFilterSegmentElement = "ContactFilterSegmentElement"
+)
+
+// SegmentElement represents an element of a contact segment, Either a contact list
+// or a contact filter whose contacts are included in, Or excluded from, the segment.
+// List is set for ContactListSegmentElement types & Filter for ContactFilterSegmentElement types.
+type SegmentElement struct {
+	Type             string    `json:"type,omitempty"`
+	ID               int       `json:"id,omitempty,string"`
+	IsIncluded       bool      `json:"isIncluded,string"`
+	Count            int       `json:"count,omitempty,string"`
+	LastCalculatedAt Timestamp `json:"lastCalculatedAt,omitempty"`
+
+	List   *ContactList   `json:"list,omitempty"`
+	Filter *ContactFilter `json:"filter,omitempty"`
+}
+
+// ContactFilter represents an Eloqua contact filter, Selecting contacts that match its criteria.
+// Statement combines the criteria by their IDs, For example "-1 AND ( -2 OR -3 )".
+// New criteria use negative IDs. Use FilterTree & SetFilterTree to work with the
+// statement as a tree of criteria.
+type ContactFilter struct {
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	FolderID      int       `json:"folderId,omitempty,string"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy     int       `json:"updatedBy,omitempty,string"`
+	Permissions   []string  `json:"permissions,omitempty"`
+	Count         int       `json:"count,omitempty,string"`
+
+	Scope     string            `json:"scope,omitempty"`
+	Statement string            `json:"statement,omitempty"`
+	Criteria  []FilterCriterion `json:"criteria,omitempty"`
+}
+
+// FilterCriterion represents a single criterion of a contact filter.
+// Only the properties relevant to the criterion's type are set, For example
+// FieldID & Condition for a ContactFieldComparisonCriterion.
+type FilterCriterion struct {
+	Type      string           `json:"type,omitempty"`
+	ID        int              `json:"id,omitempty,string"`
+	FieldID   int              `json:"fieldId,omitempty,string"`
+	ListID    int              `json:"listId,omitempty,string"`
+	SegmentID int              `json:"segmentId,omitempty,string"`
+	FilterID  int              `json:"filterId,omitempty,string"`
+	Condition *FilterCondition `json:"condition,omitempty"`
+}
+
+// FilterCondition represents the comparison a filter criterion makes.
+// Type is the kind of value compared, Such as TextValueCondition or DateValueCondition.
+type FilterCondition struct {
+	Type         string `json:"type,omitempty"`
+	Operator     string `json:"operator,omitempty"`
+	Value        string `json:"value,omitempty"`
+	OptionListID int    `json:"optionListId,omitempty,string"`
+}
+
+// NewContactListSegmentElement creates a segment element that includes, Or excludes,
+// the contacts of the contact list with the given ID.
+func NewContactListSegmentElement(listID int, isIncluded bool) SegmentElement {
+	return SegmentElement{
+		Type:       ContactListSegmentElement,
+		IsIncluded: isIncluded,
+		List:       &ContactList{Type: "ContactList", ID: listID},
+	}
+}
+
+// NewContactFilterSegmentElement creates a segment element that includes, Or excludes,
+// the contacts matching the given filter. The element holds a copy of the filter,
+// So the given filter is left unchanged.
+func NewContactFilterSegmentElement(filter *ContactFilter, isIncluded bool) SegmentElement {
+	elementFilter := *filter
+	if elementFilter.Type == "" {
+		elementFilter.Type = "ContactFilter"
+	}
+	return SegmentElement{
+		Type:       ContactFilterSegmentElement,
+		IsIncluded: isIncluded,
+		Filter:     &elementFilter,
+	}
 }
 
 // Create a new contact segment in eloqua
 func (e *ContactSegmentService) Create(name string, contactSegment *ContactSegment) (*ContactSegment, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, contactSegment)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ContactSegmentService) CreateWithContext(ctx context.Context, name string, contactSegment *ContactSegment) (*ContactSegment, *Response, error) {
 	if contactSegment == nil {
 		contactSegment = &ContactSegment{}
 	}
 	contactSegment.Name = name
 
 	endpoint := "/assets/contact/segment"
-	resp, err := e.client.postRequestDecode(endpoint, contactSegment)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, contactSegment)
 	return contactSegment, resp, err
 }
 
 // Get an contact segment object via its ID
 func (e *ContactSegmentService) Get(id int) (*ContactSegment, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ContactSegmentService) GetWithContext(ctx context.Context, id int) (*ContactSegment, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/contact/segment/%d?depth=complete", id)
 	contactSegment := &ContactSegment{}
-	resp, err := e.client.getRequestDecode(endpoint, contactSegment)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, contactSegment)
 	return contactSegment, resp, err
 }
 
 // List many eloqua contact segments
 func (e *ContactSegmentService) List(opts *ListOptions) ([]ContactSegment, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ContactSegmentService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ContactSegment, *Response, error) {
 	endpoint := "/assets/contact/segments"
 	contactSegments := new([]ContactSegment)
-	resp, err := e.client.getRequestListDecode(endpoint, contactSegments, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contactSegments, opts)
 	return *contactSegments, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua contact segments
+func (e *ContactSegmentService) ListPager(opts *ListOptions) *Pager[ContactSegment] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua contact segments, Requesting each page in turn
+func (e *ContactSegmentService) ListAll(ctx context.Context, opts *ListOptions) ([]ContactSegment, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing contact segment in eloqua
 func (e *ContactSegmentService) Update(id int, name string, contactSegment *ContactSegment) (*ContactSegment, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, contactSegment)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ContactSegmentService) UpdateWithContext(ctx context.Context, id int, name string, contactSegment *ContactSegment) (*ContactSegment, *Response, error) {
 	if contactSegment == nil {
 		contactSegment = &ContactSegment{}
 	}
@@ -70,14 +192,44 @@ func (e *ContactSegmentService) Update(id int, name string, contactSegment *Cont
 	contactSegment.Name = name
 
 	endpoint := fmt.Sprintf("/assets/contact/segment/%d", contactSegment.ID)
-	resp, err := e.client.putRequestDecode(endpoint, contactSegment)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, contactSegment)
 	return contactSegment, resp, err
 }
 
 // Delete an existing contact segment from eloqua
 func (e *ContactSegmentService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ContactSegmentService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	contactSegment := &ContactSegment{ID: id}
 	endpoint := fmt.Sprintf("/assets/contact/segment/%d", contactSegment.ID)
-	resp, err := e.client.deleteRequest(endpoint, contactSegment)
+	resp, err := e.client.deleteRequest(ctx, endpoint, contactSegment)
 	return resp, err
 }
+
+// ListContacts lists the contacts that are currently members of the contact segment with the given ID
+func (e *ContactSegmentService) ListContacts(id int, opts *ListOptions) ([]Contact, *Response, error) {
+	return e.ListContactsWithContext(context.Background(), id, opts)
+}
+
+// ListContactsWithContext is like ListContacts but performs the request with the given context.
+func (e *ContactSegmentService) ListContactsWithContext(ctx context.Context, id int, opts *ListOptions) ([]Contact, *Response, error) {
+	endpoint := fmt.Sprintf("/data/contacts/segment/%d", id)
+	contacts := new([]Contact)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contacts, opts)
+	return *contacts, resp, err
+}
+
+// ListContactsPager returns a Pager to iterate over every page of contacts in the contact segment with the given ID
+func (e *ContactSegmentService) ListContactsPager(id int, opts *ListOptions) *Pager[Contact] {
+	return newPager(opts, func(ctx context.Context, opts *ListOptions) ([]Contact, *Response, error) {
+		return e.ListContactsWithContext(ctx, id, opts)
+	})
+}
+
+// ListAllContacts lists every contact in the contact segment with the given ID, Requesting each page in turn
+func (e *ContactSegmentService) ListAllContacts(ctx context.Context, id int, opts *ListOptions) ([]Contact, error) {
+	return e.ListContactsPager(id, opts).All(ctx)
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -155,3 +156,99 @@ func TestContactSegmentDelete(t *testing.T) {
 		t.Error("ContactSegments.Delete request failed")
 	}
 }
+
+func TestContactSegmentGetElements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/contact/segment/1006", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, `{"type":"ContactSegment","id":"1006","name":"Segment","elements":[
+			{"type":"ContactListSegmentElement","id":"1","isIncluded":"true","count":"20","list":{"type":"ContactList","id":"55","name":"A List"}},
+			{"type":"ContactFilterSegmentElement","id":"2","isIncluded":"false","filter":{"type":"ContactFilter","id":"7","scope":"local","statement":"-1 AND -2",
+				"criteria":[{"type":"ContactFieldComparisonCriterion","id":"-1","fieldId":"100001","condition":{"type":"TextValueCondition","operator":"equal","value":"UK"}},
+				{"type":"ContactFieldComparisonCriterion","id":"-2","fieldId":"100002","condition":{"type":"NumericValueCondition","operator":"greaterThan","value":"5"}}]}}
+		]}`)
+	})
+
+	contactSegment, _, err := client.ContactSegments.Get(1006)
+	if err != nil {
+		t.Fatalf("ContactSegments.Get recieved error: %v", err)
+	}
+
+	want := []SegmentElement{
+		{
+			Type: ContactListSegmentElement, ID: 1, IsIncluded: true, Count: 20,
+			List: &ContactList{Type: "ContactList", ID: 55, Name: "A List"},
+		},
+		{
+			Type: ContactFilterSegmentElement, ID: 2, IsIncluded: false,
+			Filter: &ContactFilter{
+				Type: "ContactFilter", ID: 7, Scope: "local", Statement: "-1 AND -2",
+				Criteria: []FilterCriterion{
+					NewContactFieldCriterion(100001, TextCondition("equal", "UK")),
+					NewContactFieldCriterion(100002, NumericCondition("greaterThan", 5)),
+				},
+			},
+		},
+	}
+	want[1].Filter.Criteria[0].ID = -1
+	want[1].Filter.Criteria[1].ID = -2
+
+	testModels(t, "ContactSegments.Get elements", contactSegment.Elements, want)
+}
+
+func TestContactSegmentElementsJSON(t *testing.T) {
+	filter := &ContactFilter{Name: "UK Contacts"}
+	if err := filter.SetFilterTree(FilterMatch(NewContactFieldCriterion(100001, TextCondition("equal", "UK")))); err != nil {
+		t.Fatalf("SetFilterTree recieved error: %v", err)
+	}
+
+	segment := &ContactSegment{
+		Name: "A Segment",
+		Elements: []SegmentElement{
+			NewContactListSegmentElement(55, true),
+			NewContactFilterSegmentElement(filter, false),
+		},
+	}
+
+	b, err := json.Marshal(segment)
+	if err != nil {
+		t.Fatalf("json.Marshal recieved error: %v", err)
+	}
+
+	want := `{"name":"A Segment","elements":[` +
+		`{"type":"ContactListSegmentElement","isIncluded":"true","list":{"type":"ContactList","id":"55"}},` +
+		`{"type":"ContactFilterSegmentElement","isIncluded":"false","filter":{"type":"ContactFilter","name":"UK Contacts","statement":"-1",` +
+		`"criteria":[{"type":"ContactFieldComparisonCriterion","id":"-1","fieldId":"100001","condition":{"type":"TextValueCondition","operator":"equal","value":"UK"}}]}}]}`
+
+	if string(b) != want {
+		t.Errorf("ContactSegment elements JSON not as expected\nRecieved: %s\nWanted:   %s", b, want)
+	}
+	if filter.Type != "" {
+		t.Errorf("NewContactFilterSegmentElement should not change the given filter, Recieved type %q", filter.Type)
+	}
+}
+
+func TestContactSegmentContacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts/segment/66", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		if req.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"1"},{"type":"Contact","id":"2"}],"page":1,"pageSize":2,"total":3}`)
+		} else {
+			testURLParam(t, req, "page", "2")
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"3"}],"page":2,"pageSize":2,"total":3}`)
+		}
+	})
+
+	contacts, err := client.ContactSegments.ListAllContacts(context.Background(), 66, &ListOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("ContactSegments.ListAllContacts recieved error: %v", err)
+	}
+	if len(contacts) != 3 || contacts[2].ID != 3 {
+		t.Errorf("ContactSegments.ListAllContacts not as expected, Recieved: %+v", contacts)
+	}
+}
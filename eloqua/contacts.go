@@ -1,7 +1,10 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"strings"
 )
 
 // ContactService provides access to all the endpoints related
@@ -16,18 +19,18 @@ type ContactService struct {
 // Fields that are not listed in the Contact model itself can be retrieved/updated
 // using the 'FieldValues' property.
 type Contact struct {
-	Type          string `json:"type,omitempty"`
-	CurrentStatus string `json:"currentStatus,omitempty"`
-	ID            int    `json:"id,omitempty,string"`
-	CreatedAt     int    `json:"createdAt,omitempty,string"`
-	Depth         string `json:"depth,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	Depth         string    `json:"depth,omitempty"`
 	// This actually relates to the contact's email address
 	// rather than the contacts name
-	Name          string `json:"name,omitempty"`
-	UpdatedAt     int    `json:"updatedAt,omitempty,string"`
-	AccountName   string `json:"accountName,omitempty"`
-	BusinessPhone string `json:"businessPhone,omitempty"`
-	Country       string `json:"country,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	AccountName   string    `json:"accountName,omitempty"`
+	BusinessPhone string    `json:"businessPhone,omitempty"`
+	Country       string    `json:"country,omitempty"`
 
 	EmailAddress          string `json:"emailAddress,omitempty"`
 	EmailFormatPreference string `json:"emailFormatPreference,omitempty"`
@@ -39,7 +42,7 @@ type Contact struct {
 	// Job title, Not name title
 	Title string `json:"title,omitempty"`
 
-	SubscriptionDate int          `json:"subscriptionDate,omitempty,string"`
+	SubscriptionDate Timestamp    `json:"subscriptionDate,omitempty"`
 	IsBounceBack     bool         `json:"isBounceBack,omitempty,string"`
 	IsSubscribed     bool         `json:"isSubscribed,imitempty,string"`
 	FieldValues      []FieldValue `json:"fieldValues,imitempty"`
@@ -48,47 +51,229 @@ type Contact struct {
 // Create a new contact in eloqua
 // The email must not already exists otherwise Eloqua will return an error.
 func (e *ContactService) Create(emailAddress string, contact *Contact) (*Contact, *Response, error) {
+	return e.CreateWithContext(context.Background(), emailAddress, contact)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ContactService) CreateWithContext(ctx context.Context, emailAddress string, contact *Contact) (*Contact, *Response, error) {
 	if contact == nil {
 		contact = &Contact{}
 	}
 	contact.EmailAddress = emailAddress
 	endpoint := "/data/contact"
-	resp, err := e.client.postRequestDecode(endpoint, contact)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, contact)
 	return contact, resp, err
 }
 
 // Get an contact object via its ID
 func (e *ContactService) Get(id int) (*Contact, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ContactService) GetWithContext(ctx context.Context, id int) (*Contact, *Response, error) {
 	endpoint := fmt.Sprintf("/data/contact/%d?depth=complete", id)
 	contact := &Contact{}
-	resp, err := e.client.getRequestDecode(endpoint, contact)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, contact)
 	return contact, resp, err
 }
 
 // List many Eloqua contact objects
 func (e *ContactService) List(opts *ListOptions) ([]Contact, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ContactService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Contact, *Response, error) {
 	endpoint := "/data/contacts"
 	contacts := new([]Contact)
-	resp, err := e.client.getRequestListDecode(endpoint, contacts, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contacts, opts)
 	return *contacts, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua contact objects
+func (e *ContactService) ListPager(opts *ListOptions) *Pager[Contact] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua contact objects, Requesting each page in turn
+func (e *ContactService) ListAll(ctx context.Context, opts *ListOptions) ([]Contact, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing contact in eloqua
 func (e *ContactService) Update(id int, emailAddress string, contact *Contact) (*Contact, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, emailAddress, contact)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ContactService) UpdateWithContext(ctx context.Context, id int, emailAddress string, contact *Contact) (*Contact, *Response, error) {
 	if contact == nil {
 		contact = &Contact{}
 	}
 	contact.ID = id
 	contact.EmailAddress = emailAddress
 	endpoint := fmt.Sprintf("/data/contact/%d", contact.ID)
-	resp, err := e.client.putRequestDecode(endpoint, contact)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, contact)
+	return contact, resp, err
+}
+
+// UpdateChanges updates only the changed fields of an existing contact in eloqua,
+// Leaving any other fields as they are. Fields set to empty values are cleared.
+// The emailAddress is required by Eloqua so is always sent along with the changes,
+// An error is returned if the changes set it to a different value.
+func (e *ContactService) UpdateChanges(id int, emailAddress string, changes *Changes) (*Contact, *Response, error) {
+	return e.UpdateChangesWithContext(context.Background(), id, emailAddress, changes)
+}
+
+// UpdateChangesWithContext is like UpdateChanges but performs the request with the given context.
+func (e *ContactService) UpdateChangesWithContext(ctx context.Context, id int, emailAddress string, changes *Changes) (*Contact, *Response, error) {
+	if err := changes.check(reflect.TypeOf(Contact{})); err != nil {
+		return nil, nil, err
+	}
+	contact := &Contact{}
+	body, err := changes.bodyWith(id, "emailAddress", emailAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("/data/contact/%d", id)
+	resp, err := e.client.requestDecodeInto(ctx, endpoint, "PUT", body, contact)
 	return contact, resp, err
 }
 
 // Delete an existing contact from eloqua
 func (e *ContactService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ContactService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	contact := &Contact{ID: id}
 	endpoint := fmt.Sprintf("/data/contact/%d", contact.ID)
-	resp, err := e.client.deleteRequest(endpoint, contact)
+	resp, err := e.client.deleteRequest(ctx, endpoint, contact)
 	return resp, err
 }
+
+// UpsertResult describes which path an upsert took.
+type UpsertResult string
+
+// Upsert results
+const (
+	UpsertCreated UpsertResult = "created"
+	UpsertUpdated UpsertResult = "updated"
+)
+
+// DuplicateContactsError is returned by an upsert when more than one contact
+// matches, So that the contact to update cannot be chosen safely.
+// Matches contains the first of the matching contacts.
+type DuplicateContactsError struct {
+	Field   string
+	Value   string
+	Matches []Contact
+}
+
+func (e *DuplicateContactsError) Error() string {
+	return fmt.Sprintf("Several contacts match %s '%s', Expected at most one", e.Field, e.Value)
+}
+
+// Upsert creates the contact, Or updates the existing contact with the same email address.
+// The result reports whether the contact was created or updated.
+// A *DuplicateContactsError is returned if several contacts share the email address.
+func (e *ContactService) Upsert(contact *Contact) (*Contact, UpsertResult, *Response, error) {
+	return e.UpsertWithContext(context.Background(), contact)
+}
+
+// UpsertWithContext is like Upsert but performs the requests with the given context.
+func (e *ContactService) UpsertWithContext(ctx context.Context, contact *Contact) (*Contact, UpsertResult, *Response, error) {
+	if contact == nil || contact.EmailAddress == "" {
+		return nil, "", nil, fmt.Errorf("Contact email address is required to upsert")
+	}
+	return e.UpsertByWithContext(ctx, "emailAddress", contact.EmailAddress, contact)
+}
+
+// UpsertBy creates the contact, Or updates the existing contact whose field has the given value.
+// The field should be unique, Such as "emailAddress" or the internal name of a unique custom field like "C_CRM_ID1".
+// The field value must also be set on the contact so that it is stored when created.
+// A *DuplicateContactsError is returned if several contacts match.
+func (e *ContactService) UpsertBy(field string, value string, contact *Contact) (*Contact, UpsertResult, *Response, error) {
+	return e.UpsertByWithContext(context.Background(), field, value, contact)
+}
+
+// UpsertByWithContext is like UpsertBy but performs the requests with the given context.
+func (e *ContactService) UpsertByWithContext(ctx context.Context, field string, value string, contact *Contact) (*Contact, UpsertResult, *Response, error) {
+	if contact == nil {
+		contact = &Contact{}
+	}
+
+	existing, resp, err := e.findUnique(ctx, field, value)
+	if err != nil {
+		return nil, "", resp, err
+	}
+
+	if existing == nil {
+		created, resp, err := e.CreateWithContext(ctx, contact.EmailAddress, contact)
+		if err == nil {
+			return created, UpsertCreated, resp, nil
+		}
+		if !IsConflict(err) {
+			return nil, "", resp, err
+		}
+
+		// The contact was created since searching, So update it instead
+		existing, resp, err = e.findUnique(ctx, field, value)
+		if err != nil {
+			return nil, "", resp, err
+		}
+		if existing == nil {
+			return nil, "", resp, fmt.Errorf("Contact creation conflicted but no contact matches %s '%s'", field, value)
+		}
+	}
+
+	emailAddress := contact.EmailAddress
+	if emailAddress == "" {
+		emailAddress = existing.EmailAddress
+	}
+	updated, resp, err := e.UpdateWithContext(ctx, existing.ID, emailAddress, contact)
+	if err != nil {
+		return nil, "", resp, err
+	}
+	return updated, UpsertUpdated, resp, nil
+}
+
+// findUnique searches for the single contact whose field has the given value.
+// Every page of search results is checked, As searches by email address also return near matches.
+// Nil is returned if no contact matches.
+func (e *ContactService) findUnique(ctx context.Context, field string, value string) (*Contact, *Response, error) {
+	opts := &ListOptions{Depth: "complete", Count: 1000}
+	if err := opts.SetSearch("Contact", SearchEqual(field, value)); err != nil {
+		return nil, nil, err
+	}
+
+	pager := e.ListPager(opts)
+	defer pager.Close()
+
+	var contacts []Contact
+	var resp *Response
+	for pager.More() {
+		page, pageResp, err := pager.Next(ctx)
+		if err != nil {
+			return nil, pageResp, err
+		}
+		resp = pageResp
+
+		// Only keep contacts whose email address matches exactly, Ignoring case
+		for _, c := range page {
+			if field != "emailAddress" || strings.EqualFold(c.EmailAddress, value) {
+				contacts = append(contacts, c)
+			}
+		}
+	}
+
+	switch len(contacts) {
+	case 0:
+		return nil, resp, nil
+	case 1:
+		return &contacts[0], resp, nil
+	}
+	return nil, resp, &DuplicateContactsError{Field: field, Value: value, Matches: contacts}
+}
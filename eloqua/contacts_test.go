@@ -1,10 +1,12 @@
 package eloqua
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestContactCreate(t *testing.T) {
@@ -186,3 +188,162 @@ func TestContactDelete(t *testing.T) {
 		t.Error("Contacts.Delete request failed")
 	}
 }
+
+func TestContactGetWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, `{"type":"Contact", "id": "1", "name":"Test Contact 1"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	contact, _, err := client.Contacts.GetWithContext(ctx, 1)
+	if err != nil {
+		t.Errorf("Contacts.GetWithContext recieved error: %v", err)
+	}
+
+	want := &Contact{ID: 1, Name: "Test Contact 1", Type: "Contact"}
+	testModels(t, "Contacts.GetWithContext", contact, want)
+}
+
+func TestContactUpsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	created := false
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		switch req.URL.Query().Get("search") {
+		case "emailAddress='new@example.com'":
+			fmt.Fprint(w, `{"elements":[],"page":1,"pageSize":2,"total":0}`)
+		case "emailAddress='existing@example.com'":
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"7","emailAddress":"Existing@Example.com"},{"type":"Contact","id":"8","emailAddress":"existing@example.com.au"}],"page":1,"pageSize":2,"total":2}`)
+		default:
+			t.Errorf("Unexpected search %s", req.URL.Query().Get("search"))
+		}
+	})
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		created = true
+		fmt.Fprint(w, `{"type":"Contact","id":"9","emailAddress":"new@example.com"}`)
+	})
+	addRestHandlerFunc("/data/contact/7", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "PUT")
+		v := new(Contact)
+		json.NewDecoder(req.Body).Decode(v)
+		if v.ID != 7 || v.EmailAddress != "existing@example.com" || v.FirstName != "Jane" {
+			t.Errorf("Contacts.Upsert update body not as expected, Recieved: %+v", v)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"7","emailAddress":"existing@example.com","firstName":"Jane"}`)
+	})
+
+	contact, result, _, err := client.Contacts.Upsert(&Contact{EmailAddress: "new@example.com"})
+	if err != nil || result != UpsertCreated || contact.ID != 9 || !created {
+		t.Errorf("Contacts.Upsert create not as expected, Recieved: %+v %s %v", contact, result, err)
+	}
+
+	contact, result, _, err = client.Contacts.Upsert(&Contact{EmailAddress: "existing@example.com", FirstName: "Jane"})
+	if err != nil || result != UpsertUpdated || contact.ID != 7 {
+		t.Errorf("Contacts.Upsert update not as expected, Recieved: %+v %s %v", contact, result, err)
+	}
+
+	if _, _, _, err := client.Contacts.Upsert(&Contact{}); err == nil {
+		t.Error("Expected an error upserting a contact without an email address")
+	}
+}
+
+func TestContactUpsertBy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("search") {
+		case "C_CRM_ID1='A1'":
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"7","emailAddress":"a@example.com"}],"page":1,"pageSize":2,"total":1}`)
+		case "C_CRM_ID1='DUP'":
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"7"},{"type":"Contact","id":"8"}],"page":1,"pageSize":2,"total":2}`)
+		}
+	})
+	addRestHandlerFunc("/data/contact/7", func(w http.ResponseWriter, req *http.Request) {
+		v := new(Contact)
+		json.NewDecoder(req.Body).Decode(v)
+		if v.EmailAddress != "a@example.com" {
+			t.Errorf("Expected the existing email address to be kept, Recieved: %s", v.EmailAddress)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"7","emailAddress":"a@example.com"}`)
+	})
+
+	_, result, _, err := client.Contacts.UpsertBy("C_CRM_ID1", "A1", &Contact{FirstName: "Jane"})
+	if err != nil || result != UpsertUpdated {
+		t.Errorf("Contacts.UpsertBy not as expected, Recieved: %s %v", result, err)
+	}
+
+	_, _, _, err = client.Contacts.UpsertBy("C_CRM_ID1", "DUP", &Contact{})
+	dupErr, ok := err.(*DuplicateContactsError)
+	if !ok || len(dupErr.Matches) != 2 {
+		t.Errorf("Expected a DuplicateContactsError, Recieved: %v", err)
+	}
+
+	if _, _, _, err := client.Contacts.UpsertBy("favouriteColour", "Blue", &Contact{}); err == nil {
+		t.Error("Expected an error upserting by a field that cannot be searched")
+	}
+}
+
+func TestContactUpsertConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	searches := 0
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		searches++
+		if searches == 1 {
+			fmt.Fprint(w, `{"elements":[],"page":1,"pageSize":2,"total":0}`)
+			return
+		}
+		fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"7","emailAddress":"race@example.com"}],"page":1,"pageSize":2,"total":1}`)
+	})
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(409)
+	})
+	addRestHandlerFunc("/data/contact/7", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"7","emailAddress":"race@example.com"}`)
+	})
+
+	contact, result, _, err := client.Contacts.Upsert(&Contact{EmailAddress: "race@example.com"})
+	if err != nil || result != UpsertUpdated || contact.ID != 7 {
+		t.Errorf("Expected a conflicting create to fall back to an update, Recieved: %+v %s %v", contact, result, err)
+	}
+}
+
+func TestContactUpsertNearMatchesFirst(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("page") {
+		case "", "1":
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"5","emailAddress":"jane@example.com.au"},{"type":"Contact","id":"6","emailAddress":"jane@example.co"}],"page":1,"pageSize":2,"total":3}`)
+		case "2":
+			fmt.Fprint(w, `{"elements":[{"type":"Contact","id":"7","emailAddress":"jane@example.com"}],"page":2,"pageSize":2,"total":3}`)
+		default:
+			t.Errorf("Unexpected page %s", req.URL.Query().Get("page"))
+		}
+	})
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("Contact should be updated rather than created")
+	})
+	addRestHandlerFunc("/data/contact/7", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "PUT")
+		fmt.Fprint(w, `{"type":"Contact","id":"7","emailAddress":"jane@example.com"}`)
+	})
+
+	contact, result, _, err := client.Contacts.Upsert(&Contact{EmailAddress: "jane@example.com"})
+	if err != nil || result != UpsertUpdated || contact.ID != 7 {
+		t.Errorf("Expected the exact match after near matches to be updated, Recieved: %+v %s %v", contact, result, err)
+	}
+}
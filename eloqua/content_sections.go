@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -17,12 +18,12 @@ type ContentSectionService struct {
 type ContentSection struct {
 	Type        string      `json:"type,omitempty"`
 	ID          int         `json:"id,omitempty,string"`
-	CreatedAt   int         `json:"createdAt,omitempty,string"`
+	CreatedAt   Timestamp   `json:"createdAt,omitempty"`
 	CreatedBy   int         `json:"createdBy,omitempty,string"`
 	Depth       string      `json:"depth,omitempty"`
 	Name        string      `json:"name,omitempty"`
 	Description string      `json:"description,omitempty"`
-	UpdatedAt   int         `json:"updatedAt,omitempty,string"`
+	UpdatedAt   Timestamp   `json:"updatedAt,omitempty"`
 	UpdatedBy   int         `json:"updatedBy,omitempty,string"`
 	ContentHTML string      `json:"contentHtml,omitempty"`
 	ContentText string      `json:"contentText,omitempty"`
@@ -35,34 +36,64 @@ type ContentSection struct {
 
 // Create a new content section in eloqua
 func (e *ContentSectionService) Create(name string, contentSection *ContentSection) (*ContentSection, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, contentSection)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ContentSectionService) CreateWithContext(ctx context.Context, name string, contentSection *ContentSection) (*ContentSection, *Response, error) {
 	if contentSection == nil {
 		contentSection = &ContentSection{}
 	}
 
 	contentSection.Name = name
 	endpoint := "/assets/contentSection"
-	resp, err := e.client.postRequestDecode(endpoint, contentSection)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, contentSection)
 	return contentSection, resp, err
 }
 
 // Get a content section object via its ID
 func (e *ContentSectionService) Get(id int) (*ContentSection, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ContentSectionService) GetWithContext(ctx context.Context, id int) (*ContentSection, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/contentSection/%d?depth=complete", id)
 	contentSection := &ContentSection{}
-	resp, err := e.client.getRequestDecode(endpoint, contentSection)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, contentSection)
 	return contentSection, resp, err
 }
 
 // List many eloqua content sections
 func (e *ContentSectionService) List(opts *ListOptions) ([]ContentSection, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ContentSectionService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ContentSection, *Response, error) {
 	endpoint := "/assets/contentSections"
 	contentSections := new([]ContentSection)
-	resp, err := e.client.getRequestListDecode(endpoint, contentSections, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, contentSections, opts)
 	return *contentSections, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua content sections
+func (e *ContentSectionService) ListPager(opts *ListOptions) *Pager[ContentSection] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua content sections, Requesting each page in turn
+func (e *ContentSectionService) ListAll(ctx context.Context, opts *ListOptions) ([]ContentSection, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing content section in eloqua
 func (e *ContentSectionService) Update(id int, name string, contentSection *ContentSection) (*ContentSection, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, contentSection)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ContentSectionService) UpdateWithContext(ctx context.Context, id int, name string, contentSection *ContentSection) (*ContentSection, *Response, error) {
 	if contentSection == nil {
 		contentSection = &ContentSection{}
 	}
@@ -71,14 +102,19 @@ func (e *ContentSectionService) Update(id int, name string, contentSection *Cont
 	contentSection.Name = name
 
 	endpoint := fmt.Sprintf("/assets/contentSection/%d", contentSection.ID)
-	resp, err := e.client.putRequestDecode(endpoint, contentSection)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, contentSection)
 	return contentSection, resp, err
 }
 
 // Delete an existing content section from eloqua
 func (e *ContentSectionService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ContentSectionService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	contentSection := &ContentSection{ID: id}
 	endpoint := fmt.Sprintf("/assets/contentSection/%d", contentSection.ID)
-	resp, err := e.client.deleteRequest(endpoint, contentSection)
+	resp, err := e.client.deleteRequest(ctx, endpoint, contentSection)
 	return resp, err
 }
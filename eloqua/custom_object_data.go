@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -21,40 +22,72 @@ type CustomObjectData struct {
 	ID          int          `json:"id,omitempty,string"`
 	FieldValues []FieldValue `json:"fieldValues,omitempty"`
 	UniqueCode  string       `json:"uniqueCode,omitempty"`
-	CreatedAt   int          `json:"createdAt,omitempty,string"`
+	CreatedAt   Timestamp    `json:"createdAt,omitempty"`
 }
 
 // Create a new custom object record in eloqua
 func (e *CustomObjectDataService) Create(cdoID int, customObjectData *CustomObjectData) (*CustomObjectData, *Response, error) {
+	return e.CreateWithContext(context.Background(), cdoID, customObjectData)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *CustomObjectDataService) CreateWithContext(ctx context.Context, cdoID int, customObjectData *CustomObjectData) (*CustomObjectData, *Response, error) {
 	if customObjectData == nil {
 		customObjectData = &CustomObjectData{}
 	}
 
 	endpoint := fmt.Sprintf("/data/customObject/%d/instance", cdoID)
-	resp, err := e.client.postRequestDecode(endpoint, customObjectData)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, customObjectData)
 	return customObjectData, resp, err
 }
 
 // Get a custom object data record via its ID, Within the CDO of the given cdoID.
 func (e *CustomObjectDataService) Get(cdoID int, id int) (*CustomObjectData, *Response, error) {
+	return e.GetWithContext(context.Background(), cdoID, id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *CustomObjectDataService) GetWithContext(ctx context.Context, cdoID int, id int) (*CustomObjectData, *Response, error) {
 	endpoint := fmt.Sprintf("/data/customObject/%d/instance/%d?depth=complete", cdoID, id)
 	customObjectData := &CustomObjectData{}
-	resp, err := e.client.getRequestDecode(endpoint, customObjectData)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, customObjectData)
 	return customObjectData, resp, err
 }
 
 // List many eloqua custom object records
 func (e *CustomObjectDataService) List(cdoID int, opts *ListOptions) ([]CustomObjectData, *Response, error) {
+	return e.ListWithContext(context.Background(), cdoID, opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *CustomObjectDataService) ListWithContext(ctx context.Context, cdoID int, opts *ListOptions) ([]CustomObjectData, *Response, error) {
 	endpoint := fmt.Sprintf("/data/customObject/%d/instances", cdoID)
 	customObjectDatas := new([]CustomObjectData)
-	resp, err := e.client.getRequestListDecode(endpoint, customObjectDatas, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, customObjectDatas, opts)
 	return *customObjectDatas, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua custom object records
+func (e *CustomObjectDataService) ListPager(cdoID int, opts *ListOptions) *Pager[CustomObjectData] {
+	return newPager(opts, func(ctx context.Context, opts *ListOptions) ([]CustomObjectData, *Response, error) {
+		return e.ListWithContext(ctx, cdoID, opts)
+	})
+}
+
+// ListAll lists every eloqua custom object records, Requesting each page in turn
+func (e *CustomObjectDataService) ListAll(ctx context.Context, cdoID int, opts *ListOptions) ([]CustomObjectData, error) {
+	return e.ListPager(cdoID, opts).All(ctx)
+}
+
 // Update an existing custom object in eloqua
 // To actually update the cdo record value ensure you pass a customObjectData model
 // with its FieldValues filled.
 func (e *CustomObjectDataService) Update(cdoID int, id int, customObjectData *CustomObjectData) (*CustomObjectData, *Response, error) {
+	return e.UpdateWithContext(context.Background(), cdoID, id, customObjectData)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *CustomObjectDataService) UpdateWithContext(ctx context.Context, cdoID int, id int, customObjectData *CustomObjectData) (*CustomObjectData, *Response, error) {
 	if customObjectData == nil {
 		customObjectData = &CustomObjectData{}
 	}
@@ -62,14 +95,19 @@ func (e *CustomObjectDataService) Update(cdoID int, id int, customObjectData *Cu
 	customObjectData.ID = id
 
 	endpoint := fmt.Sprintf("/data/customObject/%d/instance/%d", cdoID, customObjectData.ID)
-	resp, err := e.client.putRequestDecode(endpoint, customObjectData)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, customObjectData)
 	return customObjectData, resp, err
 }
 
 // Delete an existing custom object record from eloqua
 func (e *CustomObjectDataService) Delete(cdoID int, id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), cdoID, id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *CustomObjectDataService) DeleteWithContext(ctx context.Context, cdoID int, id int) (*Response, error) {
 	customObjectData := &CustomObjectData{ID: id}
 	endpoint := fmt.Sprintf("/data/customObject/%d/instance/%d", cdoID, customObjectData.ID)
-	resp, err := e.client.deleteRequest(endpoint, customObjectData)
+	resp, err := e.client.deleteRequest(ctx, endpoint, customObjectData)
 	return resp, err
 }
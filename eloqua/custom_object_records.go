@@ -0,0 +1,412 @@
+package eloqua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// CustomObjectRecords maps the records of a custom object to and from a Go struct type T.
+// Struct fields are matched to custom object fields, By internal or display name, using
+// `eloqua:"FieldName"` tags. Dates, numbers & booleans are converted based on the struct field's type.
+// The record's own properties can be mapped with the `eloqua:",id"`, `eloqua:",name"`,
+// `eloqua:",uniqueCode"` & `eloqua:",createdAt"` tags, And `,omitempty` skips zero values on write.
+//
+//	type Order struct {
+//		ID      int       `eloqua:",id"`
+//		Number  string    `eloqua:"Order_Number1"`
+//		Total   float64   `eloqua:"Order Total"`
+//		Shipped bool      `eloqua:"Shipped1"`
+//		OrderAt time.Time `eloqua:"Order_Date1,omitempty"`
+//	}
+//
+//	orders := eloqua.NewCustomObjectRecords[Order](client, 4)
+//	order, resp, err := orders.Get(10)
+type CustomObjectRecords[T any] struct {
+	client *Client
+	cdoID  int
+}
+
+// NewCustomObjectRecords creates a mapping between the records of the custom object
+// with the given cdoID and the struct type T.
+func NewCustomObjectRecords[T any](client *Client, cdoID int) *CustomObjectRecords[T] {
+	return &CustomObjectRecords[T]{client: client, cdoID: cdoID}
+}
+
+// Create a new custom object record in eloqua from the given struct
+func (r *CustomObjectRecords[T]) Create(record *T) (*T, *Response, error) {
+	return r.CreateWithContext(context.Background(), record)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (r *CustomObjectRecords[T]) CreateWithContext(ctx context.Context, record *T) (*T, *Response, error) {
+	data, err := r.marshal(ctx, record)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, resp, err := r.client.CustomObjectData.CreateWithContext(ctx, r.cdoID, data)
+	if err != nil {
+		return nil, resp, err
+	}
+	created, err := r.unmarshal(ctx, data)
+	return created, resp, err
+}
+
+// Get a custom object record via its ID
+func (r *CustomObjectRecords[T]) Get(id int) (*T, *Response, error) {
+	return r.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (r *CustomObjectRecords[T]) GetWithContext(ctx context.Context, id int) (*T, *Response, error) {
+	data, resp, err := r.client.CustomObjectData.GetWithContext(ctx, r.cdoID, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	record, err := r.unmarshal(ctx, data)
+	return record, resp, err
+}
+
+// List many custom object records
+func (r *CustomObjectRecords[T]) List(opts *ListOptions) ([]T, *Response, error) {
+	return r.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+// Records are requested with complete depth so that their field values are included.
+func (r *CustomObjectRecords[T]) ListWithContext(ctx context.Context, opts *ListOptions) ([]T, *Response, error) {
+	listOpts := ListOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.Depth = "complete"
+
+	data, resp, err := r.client.CustomObjectData.ListWithContext(ctx, r.cdoID, &listOpts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	records := make([]T, len(data))
+	for i := range data {
+		record, err := r.unmarshal(ctx, &data[i])
+		if err != nil {
+			return nil, resp, err
+		}
+		records[i] = *record
+	}
+	return records, resp, nil
+}
+
+// ListPager returns a Pager to iterate over every page of custom object records
+func (r *CustomObjectRecords[T]) ListPager(opts *ListOptions) *Pager[T] {
+	return newPager(opts, r.ListWithContext)
+}
+
+// ListAll lists every custom object record, Requesting each page in turn
+func (r *CustomObjectRecords[T]) ListAll(ctx context.Context, opts *ListOptions) ([]T, error) {
+	return r.ListPager(opts).All(ctx)
+}
+
+// Update an existing custom object record in eloqua from the given struct
+func (r *CustomObjectRecords[T]) Update(id int, record *T) (*T, *Response, error) {
+	return r.UpdateWithContext(context.Background(), id, record)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (r *CustomObjectRecords[T]) UpdateWithContext(ctx context.Context, id int, record *T) (*T, *Response, error) {
+	data, err := r.marshal(ctx, record)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, resp, err := r.client.CustomObjectData.UpdateWithContext(ctx, r.cdoID, id, data)
+	if err != nil {
+		return nil, resp, err
+	}
+	updated, err := r.unmarshal(ctx, data)
+	return updated, resp, err
+}
+
+// Delete an existing custom object record from eloqua
+func (r *CustomObjectRecords[T]) Delete(id int) (*Response, error) {
+	return r.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (r *CustomObjectRecords[T]) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
+	return r.client.CustomObjectData.DeleteWithContext(ctx, r.cdoID, id)
+}
+
+// marshal converts a struct to a record using the custom object's fields.
+func (r *CustomObjectRecords[T]) marshal(ctx context.Context, record *T) (*CustomObjectData, error) {
+	fields, err := r.client.Fields.CustomObject(ctx, r.cdoID)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalCustomObjectData(fields, record)
+}
+
+// unmarshal converts a record to a struct using the custom object's fields.
+func (r *CustomObjectRecords[T]) unmarshal(ctx context.Context, data *CustomObjectData) (*T, error) {
+	fields, err := r.client.Fields.CustomObject(ctx, r.cdoID)
+	if err != nil {
+		return nil, err
+	}
+	record := new(T)
+	err = UnmarshalCustomObjectData(fields, data, record)
+	return record, err
+}
+
+// recordField is a struct field mapped to a custom object field or record property.
+type recordField struct {
+	index     int
+	name      string
+	property  string
+	omitEmpty bool
+}
+
+// recordFields parses the eloqua tags of a struct type.
+// Unexported fields are skipped, And fields mapped to record properties must be of a matching kind.
+func recordFields(t reflect.Type) ([]recordField, error) {
+	fields := []recordField{}
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("eloqua")
+		if !ok || tag == "-" || t.Field(i).PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		field := recordField{index: i, name: parts[0]}
+		for _, opt := range parts[1:] {
+			switch opt {
+			case "omitempty":
+				field.omitEmpty = true
+			case "id", "name", "uniqueCode", "createdAt":
+				field.property = opt
+			}
+		}
+		if field.name == "" && field.property == "" {
+			field.name = t.Field(i).Name
+		}
+		if err := checkPropertyKind(t.Field(i), field.property); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// checkPropertyKind checks that a struct field can hold the record property it is mapped to.
+func checkPropertyKind(sf reflect.StructField, property string) error {
+	kind := sf.Type.Kind()
+	switch property {
+	case "id":
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return nil
+		}
+		return fmt.Errorf("Struct field %s must be an int to hold the record id, Recieved %s", sf.Name, sf.Type)
+	case "name", "uniqueCode":
+		if kind != reflect.String {
+			return fmt.Errorf("Struct field %s must be a string to hold the record %s, Recieved %s", sf.Name, property, sf.Type)
+		}
+	}
+	return nil
+}
+
+// structValue returns the struct value pointed to by v.
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("Expected a pointer to a struct, Recieved %T", v)
+	}
+	return rv.Elem(), nil
+}
+
+// MarshalCustomObjectData converts a struct, With eloqua tags, to a custom object record
+// using the custom object's fields.
+func MarshalCustomObjectData(fields *FieldSet, v interface{}) (*CustomObjectData, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	recFields, err := recordFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	data := &CustomObjectData{}
+	for _, f := range recFields {
+		value := rv.Field(f.index)
+		if f.omitEmpty && value.IsZero() {
+			continue
+		}
+
+		switch f.property {
+		case "id":
+			data.ID = int(value.Int())
+			continue
+		case "name":
+			data.Name = value.String()
+			continue
+		case "uniqueCode":
+			data.UniqueCode = value.String()
+			continue
+		case "createdAt":
+			// Set by Eloqua
+			continue
+		}
+
+		if err := fields.Set(&data.FieldValues, f.name, recordValue(value)); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// recordValue converts a struct field to a value accepted by FieldSet.Set.
+func recordValue(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case time.Time, Timestamp:
+		if value.IsZero() {
+			return ""
+		}
+		return v
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.String:
+		return value.String()
+	}
+	return value.Interface()
+}
+
+// UnmarshalCustomObjectData converts a custom object record to the struct, With eloqua tags,
+// pointed to by v using the custom object's fields.
+// Fields without a value in the record are left unchanged.
+func UnmarshalCustomObjectData(fields *FieldSet, data *CustomObjectData, v interface{}) error {
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	recFields, err := recordFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range recFields {
+		value := rv.Field(f.index)
+
+		switch f.property {
+		case "id":
+			value.SetInt(int64(data.ID))
+			continue
+		case "name":
+			value.SetString(data.Name)
+			continue
+		case "uniqueCode":
+			value.SetString(data.UniqueCode)
+			continue
+		case "createdAt":
+			if err := setRecordValue(value, FieldData{Value: fmt.Sprint(int64(data.CreatedAt)), IsSet: data.CreatedAt != 0}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fieldData := fields.Get(data.FieldValues, f.name)
+		if fieldData.Err() != nil {
+			return fieldData.Err()
+		}
+		if !fieldData.IsSet {
+			continue
+		}
+		if err := setRecordValue(value, fieldData); err != nil {
+			return fmt.Errorf("Cannot set %s from field %s: %v", rv.Type().Field(f.index).Name, f.name, err)
+		}
+	}
+	return nil
+}
+
+// setRecordValue sets a struct field from a field value, Converting based on the struct field's type.
+func setRecordValue(value reflect.Value, data FieldData) error {
+	if value.Kind() == reflect.Ptr {
+		if data.Value == "" {
+			value.Set(reflect.Zero(value.Type()))
+			return nil
+		}
+		value.Set(reflect.New(value.Type().Elem()))
+		value = value.Elem()
+	}
+
+	switch value.Interface().(type) {
+	case time.Time:
+		t, err := data.Time()
+		if err != nil {
+			return err
+		}
+		value.Set(reflect.ValueOf(t))
+		return nil
+	case Timestamp:
+		t, err := data.Time()
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(NewTimestamp(t)))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(data.Value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := data.Int()
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := data.Int()
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return errors.New("Negative value for unsigned field")
+		}
+		value.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := data.Float()
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	case reflect.Bool:
+		b, err := data.Bool()
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	default:
+		return fmt.Errorf("Unsupported struct field type %s", value.Type())
+	}
+	return nil
+}
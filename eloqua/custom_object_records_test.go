@@ -0,0 +1,226 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testOrder struct {
+	ID        int       `eloqua:",id"`
+	Name      string    `eloqua:",name"`
+	CreatedAt time.Time `eloqua:",createdAt"`
+	Number    string    `eloqua:"Order_Number1"`
+	Total     float64   `eloqua:"Order Total"`
+	Quantity  int       `eloqua:"Quantity1"`
+	Shipped   bool      `eloqua:"Shipped1"`
+	OrderedAt time.Time `eloqua:"Order_Date1,omitempty"`
+	Notes     *string   `eloqua:"Notes1,omitempty"`
+	Internal  string
+}
+
+// addOrderFieldsHandler serves the definition of the test order custom object.
+func addOrderFieldsHandler() {
+	addRestHandlerFunc("/assets/customObject/4", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"CustomObject","id":"4","fields":[
+			{"id":"31","name":"Order Number","internalName":"Order_Number1","dataType":"text"},
+			{"id":"32","name":"Order Total","internalName":"Order_Total1","dataType":"number"},
+			{"id":"33","name":"Quantity","internalName":"Quantity1","dataType":"number"},
+			{"id":"34","name":"Shipped","internalName":"Shipped1","dataType":"text"},
+			{"id":"35","name":"Order Date","internalName":"Order_Date1","dataType":"date"},
+			{"id":"36","name":"Notes","internalName":"Notes1","dataType":"largeText"}
+		]}`)
+	})
+}
+
+const testOrderRecordJSON = `{"type":"CustomObjectData","id":"10","name":"A100","createdAt":"1420070400","fieldValues":[
+	{"id":"31","value":"A100"},{"id":"32","value":"19.99"},{"id":"33","value":"3"},
+	{"id":"34","value":"Yes"},{"id":"35","value":"1420156800"},{"id":"36","value":"Gift wrap"}
+]}`
+
+func TestCustomObjectRecordsGet(t *testing.T) {
+	setup()
+	defer teardown()
+	addOrderFieldsHandler()
+
+	addRestHandlerFunc("/data/customObject/4/instance/10", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, testOrderRecordJSON)
+	})
+
+	order, _, err := NewCustomObjectRecords[testOrder](client, 4).Get(10)
+	if err != nil {
+		t.Fatalf("CustomObjectRecords.Get recieved error: %v", err)
+	}
+
+	notes := "Gift wrap"
+	want := &testOrder{
+		ID:        10,
+		Name:      "A100",
+		CreatedAt: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		Number:    "A100",
+		Total:     19.99,
+		Quantity:  3,
+		Shipped:   true,
+		OrderedAt: time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC),
+		Notes:     &notes,
+	}
+	testModels(t, "CustomObjectRecords.Get", order, want)
+}
+
+func TestCustomObjectRecordsCreate(t *testing.T) {
+	setup()
+	defer teardown()
+	addOrderFieldsHandler()
+
+	addRestHandlerFunc("/data/customObject/4/instance", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(CustomObjectData)
+		json.NewDecoder(req.Body).Decode(v)
+
+		want := &CustomObjectData{Name: "A100", FieldValues: []FieldValue{
+			{Type: "FieldValue", ID: 31, Value: "A100"},
+			{Type: "FieldValue", ID: 32, Value: "19.99"},
+			{Type: "FieldValue", ID: 33, Value: "3"},
+			{Type: "FieldValue", ID: 34, Value: "false"},
+		}}
+		testModels(t, "CustomObjectRecords.Create body", v, want)
+
+		fmt.Fprint(w, `{"type":"CustomObjectData","id":"10","name":"A100","fieldValues":[{"id":"31","value":"A100"},{"id":"32","value":"19.99"},{"id":"33","value":"3"},{"id":"34","value":"false"}]}`)
+	})
+
+	order, _, err := NewCustomObjectRecords[testOrder](client, 4).Create(&testOrder{Name: "A100", Number: "A100", Total: 19.99, Quantity: 3, Internal: "ignored"})
+	if err != nil {
+		t.Fatalf("CustomObjectRecords.Create recieved error: %v", err)
+	}
+	if order.ID != 10 || order.Total != 19.99 {
+		t.Errorf("CustomObjectRecords.Create not as expected, Recieved: %+v", order)
+	}
+}
+
+func TestCustomObjectRecordsListAndUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+	addOrderFieldsHandler()
+
+	addRestHandlerFunc("/data/customObject/4/instances", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		fmt.Fprintf(w, `{"elements":[%s],"page":1,"pageSize":1000,"total":1}`, testOrderRecordJSON)
+	})
+	addRestHandlerFunc("/data/customObject/4/instance/10", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "PUT")
+		v := new(CustomObjectData)
+		json.NewDecoder(req.Body).Decode(v)
+		if len(v.FieldValues) != 6 || v.FieldValues[4].Value != "1420243200" {
+			t.Errorf("CustomObjectRecords.Update body not as expected, Recieved: %+v", v.FieldValues)
+		}
+		fmt.Fprint(w, testOrderRecordJSON)
+	})
+
+	records := NewCustomObjectRecords[testOrder](client, 4)
+	orders, err := records.ListAll(context.Background(), &ListOptions{Depth: "minimal"})
+	if err != nil {
+		t.Fatalf("CustomObjectRecords.ListAll recieved error: %v", err)
+	}
+	if len(orders) != 1 || orders[0].Number != "A100" {
+		t.Fatalf("CustomObjectRecords.ListAll not as expected, Recieved: %+v", orders)
+	}
+
+	order := orders[0]
+	order.OrderedAt = order.OrderedAt.AddDate(0, 0, 1)
+	if _, _, err := records.Update(order.ID, &order); err != nil {
+		t.Errorf("CustomObjectRecords.Update recieved error: %v", err)
+	}
+}
+
+func TestCustomObjectDataMapping(t *testing.T) {
+	fields := NewFieldSet([]FieldDefinition{
+		{ID: 32, Name: "Order Total", InternalName: "Order_Total1", DataType: "number"},
+	})
+
+	if _, err := MarshalCustomObjectData(fields, testOrder{}); err == nil {
+		t.Error("Expected an error marshalling a non-pointer")
+	}
+
+	type unknownField struct {
+		Colour string `eloqua:"Colour1"`
+	}
+	if _, err := MarshalCustomObjectData(fields, &unknownField{}); err == nil {
+		t.Error("Expected an error marshalling an unknown field")
+	}
+
+	type badType struct {
+		Total []string `eloqua:"Order_Total1"`
+	}
+	data := &CustomObjectData{FieldValues: []FieldValue{{ID: 32, Value: "19.99"}}}
+	if err := UnmarshalCustomObjectData(fields, data, &badType{}); err == nil {
+		t.Error("Expected an error unmarshalling into an unsupported type")
+	}
+
+	type badValue struct {
+		Total int `eloqua:"Order Total"`
+	}
+	data.FieldValues[0].Value = "lots"
+	if err := UnmarshalCustomObjectData(fields, data, &badValue{}); err == nil {
+		t.Error("Expected an error unmarshalling an invalid number")
+	}
+}
+
+func TestCustomObjectDataPropertyKinds(t *testing.T) {
+	fields := NewFieldSet([]FieldDefinition{
+		{ID: 32, Name: "Order Total", InternalName: "Order_Total1", DataType: "number"},
+	})
+	data := &CustomObjectData{ID: 10, Name: "A100", UniqueCode: "U1"}
+
+	type stringID struct {
+		ID string `eloqua:",id"`
+	}
+	if _, err := MarshalCustomObjectData(fields, &stringID{ID: "10"}); err == nil {
+		t.Error("Expected an error marshalling a string id")
+	}
+	if err := UnmarshalCustomObjectData(fields, data, &stringID{}); err == nil {
+		t.Error("Expected an error unmarshalling into a string id")
+	}
+
+	type intName struct {
+		Name int `eloqua:",name"`
+	}
+	if _, err := MarshalCustomObjectData(fields, &intName{}); err == nil {
+		t.Error("Expected an error marshalling an int name")
+	}
+
+	type boolCode struct {
+		Code bool `eloqua:",uniqueCode"`
+	}
+	if err := UnmarshalCustomObjectData(fields, data, &boolCode{}); err == nil {
+		t.Error("Expected an error unmarshalling into a bool unique code")
+	}
+}
+
+func TestCustomObjectDataUnexportedField(t *testing.T) {
+	fields := NewFieldSet([]FieldDefinition{
+		{ID: 32, Name: "Order Total", InternalName: "Order_Total1", DataType: "number"},
+	})
+
+	type withUnexported struct {
+		Total float64 `eloqua:"Order_Total1"`
+		total float64 `eloqua:"Order Total"`
+	}
+
+	data, err := MarshalCustomObjectData(fields, &withUnexported{Total: 19.99, total: 5})
+	if err != nil {
+		t.Fatalf("MarshalCustomObjectData recieved error: %v", err)
+	}
+	testModels(t, "MarshalCustomObjectData", data.FieldValues, []FieldValue{{Type: "FieldValue", ID: 32, Value: "19.99"}})
+
+	record := &withUnexported{}
+	if err := UnmarshalCustomObjectData(fields, data, record); err != nil {
+		t.Fatalf("UnmarshalCustomObjectData recieved error: %v", err)
+	}
+	if record.Total != 19.99 || record.total != 0 {
+		t.Errorf("Expected the unexported field to be skipped, Recieved: %+v", record)
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,15 +17,15 @@ type CustomObjectService struct {
 // These fields are taken from an API response since the Eloqua documentation
 // ,at the time of building, did not appear to be correct.
 type CustomObject struct {
-	Type        string `json:"type,omitempty"`
-	ID          int    `json:"id,omitempty,string"`
-	CreatedAt   int    `json:"createdAt,omitempty,string"`
-	CreatedBy   int    `json:"createdBy,omitempty,string"`
-	Depth       string `json:"depth,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	UpdatedAt   int    `json:"updatedAt,omitempty,string"`
-	UpdatedBy   int    `json:"updatedBy,omitempty,string"`
+	Type        string    `json:"type,omitempty"`
+	ID          int       `json:"id,omitempty,string"`
+	CreatedAt   Timestamp `json:"createdAt,omitempty"`
+	CreatedBy   int       `json:"createdBy,omitempty,string"`
+	Depth       string    `json:"depth,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	UpdatedAt   Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy   int       `json:"updatedBy,omitempty,string"`
 
 	DisplayNameFieldID string              `json:"displayNameFieldId,omitempty"`
 	ContentText        string              `json:"contentText,omitempty"`
@@ -46,34 +47,64 @@ type CustomObjectField struct {
 
 // Create a new custom object in eloqua
 func (e *CustomObjectService) Create(name string, customObject *CustomObject) (*CustomObject, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, customObject)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *CustomObjectService) CreateWithContext(ctx context.Context, name string, customObject *CustomObject) (*CustomObject, *Response, error) {
 	if customObject == nil {
 		customObject = &CustomObject{}
 	}
 
 	customObject.Name = name
 	endpoint := "/assets/customObject"
-	resp, err := e.client.postRequestDecode(endpoint, customObject)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, customObject)
 	return customObject, resp, err
 }
 
 // Get a custom object via its ID
 func (e *CustomObjectService) Get(id int) (*CustomObject, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *CustomObjectService) GetWithContext(ctx context.Context, id int) (*CustomObject, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/customObject/%d?depth=complete", id)
 	customObject := &CustomObject{}
-	resp, err := e.client.getRequestDecode(endpoint, customObject)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, customObject)
 	return customObject, resp, err
 }
 
 // List many eloqua custom objects
 func (e *CustomObjectService) List(opts *ListOptions) ([]CustomObject, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *CustomObjectService) ListWithContext(ctx context.Context, opts *ListOptions) ([]CustomObject, *Response, error) {
 	endpoint := "/assets/customObjects"
 	customObjects := new([]CustomObject)
-	resp, err := e.client.getRequestListDecode(endpoint, customObjects, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, customObjects, opts)
 	return *customObjects, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua custom objects
+func (e *CustomObjectService) ListPager(opts *ListOptions) *Pager[CustomObject] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua custom objects, Requesting each page in turn
+func (e *CustomObjectService) ListAll(ctx context.Context, opts *ListOptions) ([]CustomObject, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing custom object in eloqua
 func (e *CustomObjectService) Update(id int, name string, customObject *CustomObject) (*CustomObject, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, customObject)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *CustomObjectService) UpdateWithContext(ctx context.Context, id int, name string, customObject *CustomObject) (*CustomObject, *Response, error) {
 	if customObject == nil {
 		customObject = &CustomObject{}
 	}
@@ -82,14 +113,19 @@ func (e *CustomObjectService) Update(id int, name string, customObject *CustomOb
 	customObject.Name = name
 
 	endpoint := fmt.Sprintf("/assets/customObject/%d", customObject.ID)
-	resp, err := e.client.putRequestDecode(endpoint, customObject)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, customObject)
 	return customObject, resp, err
 }
 
 // Delete an existing custom object from eloqua
 func (e *CustomObjectService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *CustomObjectService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	customObject := &CustomObject{ID: id}
 	endpoint := fmt.Sprintf("/assets/customObject/%d", customObject.ID)
-	resp, err := e.client.deleteRequest(endpoint, customObject)
+	resp, err := e.client.deleteRequest(ctx, endpoint, customObject)
 	return resp, err
 }
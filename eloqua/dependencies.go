@@ -0,0 +1,349 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DependencyService provides access to the dependencies between assets within eloqua,
+// Such as the emails & landing pages that use an image.
+type DependencyService struct {
+	client *Client
+}
+
+// Asset types referenced in dependency graphs
+const (
+	AssetTypeEmail          = "Email"
+	AssetTypeEmailHeader    = "EmailHeader"
+	AssetTypeEmailFooter    = "EmailFooter"
+	AssetTypeLandingPage    = "LandingPage"
+	AssetTypeForm           = "Form"
+	AssetTypeImage          = "Image"
+	AssetTypeHyperlink      = "Hyperlink"
+	AssetTypeContentSection = "ContentSection"
+	AssetTypeDynamicContent = "DynamicContent"
+)
+
+// dependencyEndpoints are the asset endpoints that Eloqua offers dependencies for
+var dependencyEndpoints = map[string]string{
+	AssetTypeEmail:          "/assets/email",
+	AssetTypeLandingPage:    "/assets/landingPage",
+	AssetTypeForm:           "/assets/form",
+	AssetTypeImage:          "/assets/image",
+	AssetTypeContentSection: "/assets/contentSection",
+}
+
+// AssetRef identifies an asset by its type & ID.
+type AssetRef struct {
+	Type string `json:"type,omitempty"`
+	ID   int    `json:"id,omitempty,string"`
+	Name string `json:"name,omitempty"`
+}
+
+func (r AssetRef) String() string {
+	return fmt.Sprintf("%s %d", r.Type, r.ID)
+}
+
+// key identifies the asset ignoring its name
+func (r AssetRef) key() AssetRef {
+	return AssetRef{Type: r.Type, ID: r.ID}
+}
+
+// Get lists the assets that depend on the given asset, As reported by Eloqua's dependencies endpoint.
+// Only emails, landing pages, forms, images & content sections are supported.
+func (e *DependencyService) Get(asset AssetRef) ([]AssetRef, *Response, error) {
+	return e.GetWithContext(context.Background(), asset)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *DependencyService) GetWithContext(ctx context.Context, asset AssetRef) ([]AssetRef, *Response, error) {
+	base, ok := dependencyEndpoints[asset.Type]
+	if !ok {
+		return nil, nil, fmt.Errorf("Eloqua does not provide dependencies for %s assets", asset.Type)
+	}
+
+	endpoint := fmt.Sprintf("%s/%d/dependencies", base, asset.ID)
+	raw := new(json.RawMessage)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// Dependencies may be returned as a plain array or as a list of elements
+	var dependents []AssetRef
+	if err := json.Unmarshal(*raw, &dependents); err != nil {
+		list := struct {
+			Elements []AssetRef `json:"elements"`
+		}{}
+		if err := json.Unmarshal(*raw, &list); err != nil {
+			return nil, resp, err
+		}
+		dependents = list.Elements
+	}
+	return dependents, resp, nil
+}
+
+// BuildGraph builds a dependency graph from every email, landing page & content section in eloqua,
+// Requesting each page of each asset type in turn at complete depth.
+// The given options, Which may be nil, are used for each listing.
+func (e *DependencyService) BuildGraph(ctx context.Context, opts *ListOptions) (*DependencyGraph, error) {
+	listOpts := func() *ListOptions {
+		o := ListOptions{}
+		if opts != nil {
+			o = *opts
+		}
+		o.Depth = "complete"
+		return &o
+	}
+
+	g := NewDependencyGraph()
+
+	emails, err := e.client.Emails.ListAll(ctx, listOpts())
+	if err != nil {
+		return nil, err
+	}
+	for i := range emails {
+		g.AddEmail(&emails[i])
+	}
+
+	pages, err := e.client.LandingPages.ListAll(ctx, listOpts())
+	if err != nil {
+		return nil, err
+	}
+	for i := range pages {
+		g.AddLandingPage(&pages[i])
+	}
+
+	sections, err := e.client.ContentSections.ListAll(ctx, listOpts())
+	if err != nil {
+		return nil, err
+	}
+	for i := range sections {
+		g.AddContentSection(&sections[i])
+	}
+
+	return g, nil
+}
+
+// DependencyGraph records which assets reference which other assets.
+// An asset "uses" the assets it references & is "used by" the assets referencing it.
+type DependencyGraph struct {
+	uses   map[AssetRef]map[AssetRef]bool
+	usedBy map[AssetRef]map[AssetRef]bool
+	names  map[AssetRef]string
+}
+
+// NewDependencyGraph creates an empty dependency graph.
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{
+		uses:   map[AssetRef]map[AssetRef]bool{},
+		usedBy: map[AssetRef]map[AssetRef]bool{},
+		names:  map[AssetRef]string{},
+	}
+}
+
+// AddAsset adds an asset to the graph without any references, Recording its name if set.
+func (g *DependencyGraph) AddAsset(asset AssetRef) {
+	key := asset.key()
+	if asset.Name != "" {
+		g.names[key] = asset.Name
+	}
+	if g.uses[key] == nil {
+		g.uses[key] = map[AssetRef]bool{}
+	}
+	if g.usedBy[key] == nil {
+		g.usedBy[key] = map[AssetRef]bool{}
+	}
+}
+
+// AddReference records that from uses to. References to assets without an ID are ignored.
+func (g *DependencyGraph) AddReference(from AssetRef, to AssetRef) {
+	if to.ID == 0 || from.key() == to.key() {
+		return
+	}
+	g.AddAsset(from)
+	g.AddAsset(to)
+	g.uses[from.key()][to.key()] = true
+	g.usedBy[to.key()][from.key()] = true
+}
+
+// AddDependents records that each of the dependents uses the asset,
+// Such as those returned by DependencyService.Get.
+func (g *DependencyGraph) AddDependents(asset AssetRef, dependents []AssetRef) {
+	g.AddAsset(asset)
+	for _, dependent := range dependents {
+		g.AddReference(dependent, asset)
+	}
+}
+
+// addContent records the forms, images & hyperlinks referenced by an asset's content
+func (g *DependencyGraph) addContent(from AssetRef, forms []Form, images []Image, hyperlinks []Hyperlink) {
+	for _, f := range forms {
+		g.AddReference(from, AssetRef{Type: AssetTypeForm, ID: f.ID, Name: f.Name})
+	}
+	for _, i := range images {
+		g.AddReference(from, AssetRef{Type: AssetTypeImage, ID: i.ID, Name: i.Name})
+	}
+	for _, h := range hyperlinks {
+		g.AddReference(from, AssetRef{Type: AssetTypeHyperlink, ID: h.ID, Name: h.Name})
+	}
+}
+
+// addSections records the content sections & dynamic content referenced by an asset
+func (g *DependencyGraph) addSections(from AssetRef, sections []ContentSection, dynamicContents []DynamicContent) {
+	for _, s := range sections {
+		g.AddReference(from, AssetRef{Type: AssetTypeContentSection, ID: s.ID, Name: s.Name})
+	}
+	for _, dc := range dynamicContents {
+		g.AddReference(from, AssetRef{Type: AssetTypeDynamicContent, ID: dc.ID, Name: dc.Name})
+	}
+}
+
+// AddEmail records the assets referenced by the email.
+// The email should have been retrieved at complete depth.
+func (g *DependencyGraph) AddEmail(email *Email) {
+	ref := AssetRef{Type: AssetTypeEmail, ID: email.ID, Name: email.Name}
+	g.AddAsset(ref)
+	g.addContent(ref, email.Forms, email.Images, email.Hyperlinks)
+	g.addSections(ref, email.ContentSections, email.DynamicContents)
+	for _, lp := range email.LandingPages {
+		g.AddReference(ref, AssetRef{Type: AssetTypeLandingPage, ID: lp.ID, Name: lp.Name})
+	}
+	g.AddReference(ref, AssetRef{Type: AssetTypeEmailHeader, ID: email.EmailHeaderID})
+	g.AddReference(ref, AssetRef{Type: AssetTypeEmailFooter, ID: email.EmailFooterID})
+}
+
+// AddLandingPage records the assets referenced by the landing page.
+// The landing page should have been retrieved at complete depth.
+func (g *DependencyGraph) AddLandingPage(page *LandingPage) {
+	ref := AssetRef{Type: AssetTypeLandingPage, ID: page.ID, Name: page.Name}
+	g.AddAsset(ref)
+	g.addContent(ref, page.Forms, page.Images, page.Hyperlinks)
+	g.addSections(ref, page.ContentSections, page.DynamicContents)
+}
+
+// AddContentSection records the assets referenced by the content section.
+func (g *DependencyGraph) AddContentSection(section *ContentSection) {
+	ref := AssetRef{Type: AssetTypeContentSection, ID: section.ID, Name: section.Name}
+	g.AddAsset(ref)
+	g.addContent(ref, section.Forms, section.Images, section.Hyperlinks)
+}
+
+// refs returns the assets in set sorted by type & ID, With their names
+func (g *DependencyGraph) refs(set map[AssetRef]bool) []AssetRef {
+	refs := make([]AssetRef, 0, len(set))
+	for ref := range set {
+		refs = append(refs, AssetRef{Type: ref.Type, ID: ref.ID, Name: g.names[ref]})
+	}
+	sortAssetRefs(refs)
+	return refs
+}
+
+// sortAssetRefs sorts assets by type & then ID
+func sortAssetRefs(refs []AssetRef) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Type != refs[j].Type {
+			return refs[i].Type < refs[j].Type
+		}
+		return refs[i].ID < refs[j].ID
+	})
+}
+
+// Assets returns every asset in the graph.
+func (g *DependencyGraph) Assets() []AssetRef {
+	all := make(map[AssetRef]bool, len(g.uses))
+	for ref := range g.uses {
+		all[ref] = true
+	}
+	return g.refs(all)
+}
+
+// Uses returns the assets directly referenced by the given asset.
+func (g *DependencyGraph) Uses(asset AssetRef) []AssetRef {
+	return g.refs(g.uses[asset.key()])
+}
+
+// UsedBy returns the assets that directly reference the given asset,
+// For example UsedBy(AssetRef{Type: AssetTypeImage, ID: 42}) lists what uses image 42.
+func (g *DependencyGraph) UsedBy(asset AssetRef) []AssetRef {
+	return g.refs(g.usedBy[asset.key()])
+}
+
+// DependencyError is returned by DependencyGraph.DeleteOrder when an asset
+// cannot be deleted because assets that are not being deleted still use it.
+type DependencyError struct {
+	Asset  AssetRef
+	UsedBy []AssetRef
+}
+
+func (e *DependencyError) Error() string {
+	users := make([]string, len(e.UsedBy))
+	for i, ref := range e.UsedBy {
+		users[i] = ref.String()
+	}
+	return fmt.Sprintf("%s cannot be deleted as it is used by %s", e.Asset, strings.Join(users, ", "))
+}
+
+// DeleteOrder orders the given assets so that each is deleted before the assets it uses,
+// Avoiding Eloqua's 412 "has dependencies" errors.
+// A *DependencyError is returned if an asset is used by assets that are not being deleted.
+func (g *DependencyGraph) DeleteOrder(assets ...AssetRef) ([]AssetRef, error) {
+	deleting := make(map[AssetRef]bool, len(assets))
+	for _, asset := range assets {
+		deleting[asset.key()] = true
+	}
+
+	// Count the users of each asset that are also being deleted
+	remaining := make(map[AssetRef]int, len(deleting))
+	for _, ref := range g.refs(deleting) {
+		asset := ref.key()
+		var blockers []AssetRef
+		for user := range g.usedBy[asset] {
+			if deleting[user] {
+				remaining[asset]++
+			} else {
+				blockers = append(blockers, user)
+			}
+		}
+		if len(blockers) > 0 {
+			blocked := make(map[AssetRef]bool, len(blockers))
+			for _, b := range blockers {
+				blocked[b] = true
+			}
+			return nil, &DependencyError{Asset: ref, UsedBy: g.refs(blocked)}
+		}
+	}
+
+	ready := map[AssetRef]bool{}
+	for asset := range deleting {
+		if remaining[asset] == 0 {
+			ready[asset] = true
+		}
+	}
+
+	order := make([]AssetRef, 0, len(deleting))
+	for len(ready) > 0 {
+		next := g.refs(ready)[0]
+		key := next.key()
+		delete(ready, key)
+		order = append(order, next)
+
+		for used := range g.uses[key] {
+			if !deleting[used] {
+				continue
+			}
+			remaining[used]--
+			if remaining[used] == 0 {
+				ready[used] = true
+			}
+		}
+	}
+
+	if len(order) < len(deleting) {
+		return order, fmt.Errorf("Assets reference each other in a cycle, %d could not be ordered", len(deleting)-len(order))
+	}
+	return order, nil
+}
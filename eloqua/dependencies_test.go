@@ -0,0 +1,151 @@
+package eloqua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func testDependencyGraph() *DependencyGraph {
+	g := NewDependencyGraph()
+	g.AddEmail(&Email{
+		ID: 1, Name: "Welcome",
+		Images:          []Image{{ID: 42, Name: "Logo"}},
+		ContentSections: []ContentSection{{ID: 7}},
+		LandingPages:    []LandingPage{{ID: 3}},
+		EmailFooterID:   9,
+	})
+	g.AddLandingPage(&LandingPage{ID: 3, Name: "Signup", Forms: []Form{{ID: 5}}, Images: []Image{{ID: 42}}})
+	g.AddContentSection(&ContentSection{ID: 7, Name: "Banner", Images: []Image{{ID: 43}}, Hyperlinks: []Hyperlink{{ID: 11}}})
+	return g
+}
+
+func TestDependencyGraphUses(t *testing.T) {
+	g := testDependencyGraph()
+
+	usedBy := g.UsedBy(AssetRef{Type: AssetTypeImage, ID: 42})
+	want := []AssetRef{{Type: AssetTypeEmail, ID: 1, Name: "Welcome"}, {Type: AssetTypeLandingPage, ID: 3, Name: "Signup"}}
+	testModels(t, "DependencyGraph.UsedBy", usedBy, want)
+
+	uses := g.Uses(AssetRef{Type: AssetTypeEmail, ID: 1})
+	want = []AssetRef{
+		{Type: AssetTypeContentSection, ID: 7, Name: "Banner"},
+		{Type: AssetTypeEmailFooter, ID: 9},
+		{Type: AssetTypeImage, ID: 42, Name: "Logo"},
+		{Type: AssetTypeLandingPage, ID: 3, Name: "Signup"},
+	}
+	testModels(t, "DependencyGraph.Uses", uses, want)
+
+	if len(g.Assets()) != 8 {
+		t.Errorf("DependencyGraph.Assets not as expected, Recieved: %+v", g.Assets())
+	}
+}
+
+func TestDependencyGraphDeleteOrder(t *testing.T) {
+	g := testDependencyGraph()
+
+	order, err := g.DeleteOrder(
+		AssetRef{Type: AssetTypeImage, ID: 42},
+		AssetRef{Type: AssetTypeLandingPage, ID: 3},
+		AssetRef{Type: AssetTypeEmail, ID: 1},
+		AssetRef{Type: AssetTypeContentSection, ID: 7},
+	)
+	if err != nil {
+		t.Fatalf("DependencyGraph.DeleteOrder recieved error: %v", err)
+	}
+
+	want := []AssetRef{
+		{Type: AssetTypeEmail, ID: 1, Name: "Welcome"},
+		{Type: AssetTypeContentSection, ID: 7, Name: "Banner"},
+		{Type: AssetTypeLandingPage, ID: 3, Name: "Signup"},
+		{Type: AssetTypeImage, ID: 42, Name: "Logo"},
+	}
+	testModels(t, "DependencyGraph.DeleteOrder", order, want)
+}
+
+func TestDependencyGraphDeleteOrderErrors(t *testing.T) {
+	g := testDependencyGraph()
+
+	_, err := g.DeleteOrder(AssetRef{Type: AssetTypeImage, ID: 42}, AssetRef{Type: AssetTypeLandingPage, ID: 3})
+	var depErr *DependencyError
+	if !errors.As(err, &depErr) {
+		t.Fatalf("DependencyGraph.DeleteOrder expected a *DependencyError, Recieved: %v", err)
+	}
+	if err.Error() != "Image 42 cannot be deleted as it is used by Email 1" {
+		t.Errorf("DependencyGraph.DeleteOrder error not as expected, Recieved: %v", err)
+	}
+
+	g.AddReference(AssetRef{Type: AssetTypeContentSection, ID: 7}, AssetRef{Type: AssetTypeContentSection, ID: 8})
+	g.AddReference(AssetRef{Type: AssetTypeContentSection, ID: 8}, AssetRef{Type: AssetTypeContentSection, ID: 7})
+	_, err = g.DeleteOrder(
+		AssetRef{Type: AssetTypeEmail, ID: 1},
+		AssetRef{Type: AssetTypeContentSection, ID: 7},
+		AssetRef{Type: AssetTypeContentSection, ID: 8},
+	)
+	if err == nil {
+		t.Error("DependencyGraph.DeleteOrder expected error for a cycle")
+	}
+}
+
+func TestDependencyGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/image/42/dependencies", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, `[{"type":"Email","id":"1","name":"Welcome"},{"type":"LandingPage","id":"3"}]`)
+	})
+	addRestHandlerFunc("/assets/form/5/dependencies", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, `{"elements":[{"type":"LandingPage","id":"3","name":"Signup"}]}`)
+	})
+
+	image := AssetRef{Type: AssetTypeImage, ID: 42}
+	dependents, _, err := client.Dependencies.Get(image)
+	if err != nil {
+		t.Fatalf("Dependencies.Get recieved error: %v", err)
+	}
+	testModels(t, "Dependencies.Get", dependents, []AssetRef{{Type: "Email", ID: 1, Name: "Welcome"}, {Type: "LandingPage", ID: 3}})
+
+	dependents, _, err = client.Dependencies.Get(AssetRef{Type: AssetTypeForm, ID: 5})
+	if err != nil {
+		t.Fatalf("Dependencies.Get recieved error: %v", err)
+	}
+	testModels(t, "Dependencies.Get(elements)", dependents, []AssetRef{{Type: "LandingPage", ID: 3, Name: "Signup"}})
+
+	if _, _, err := client.Dependencies.Get(AssetRef{Type: AssetTypeHyperlink, ID: 11}); err == nil {
+		t.Error("Dependencies.Get expected error for an unsupported asset type")
+	}
+
+	g := NewDependencyGraph()
+	g.AddDependents(image, dependents)
+	testModels(t, "DependencyGraph.AddDependents", g.Uses(AssetRef{Type: AssetTypeLandingPage, ID: 3}), []AssetRef{image})
+}
+
+func TestDependencyBuildGraph(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/emails", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		fmt.Fprint(w, `{"elements":[{"type":"Email","id":"1","images":[{"type":"ImageFile","id":"42"}]}],"page":1,"pageSize":100,"total":1}`)
+	})
+	addRestHandlerFunc("/assets/landingPages", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		fmt.Fprint(w, `{"elements":[{"type":"LandingPage","id":"3","images":[{"type":"ImageFile","id":"42"}]}],"page":1,"pageSize":100,"total":1}`)
+	})
+	addRestHandlerFunc("/assets/contentSections", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "depth", "complete")
+		fmt.Fprint(w, `{"elements":[],"page":1,"pageSize":100,"total":0}`)
+	})
+
+	g, err := client.Dependencies.BuildGraph(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Dependencies.BuildGraph recieved error: %v", err)
+	}
+
+	want := []AssetRef{{Type: AssetTypeEmail, ID: 1}, {Type: AssetTypeLandingPage, ID: 3}}
+	testModels(t, "Dependencies.BuildGraph", g.UsedBy(AssetRef{Type: AssetTypeImage, ID: 42}), want)
+}
@@ -25,6 +25,12 @@ When performing an update or create request all Eloqua-required properties are r
 	// Send the create request with our landing page name & above input
 	landingPage, resp, err := client.LandingPages.Create("My new page", &landingPageInput)
 
+Every service method has a WithContext variant that accepts a context.Context as its first argument, allowing requests to be cancelled or given a deadline.
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	email, resp, err := client.Emails.GetWithContext(ctx, 5)
+
 For most listing requests you can pass through some listing options to control search, count & paging. Here's an example of listing out users:
 
 	listOptions := eloqua.ListOptions{Count: 5, Search: "name=test*", Page: 2}
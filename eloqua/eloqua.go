@@ -2,9 +2,8 @@ package eloqua
 
 import (
 	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -25,6 +24,10 @@ type Client struct {
 	// The base URL for the eloqua instance
 	BaseURL string
 
+	// Details of the authenticated user, site & instance URLs.
+	// Only set when the client is created via the Eloqua login endpoint.
+	Login *LoginInfo
+
 	// Eloqua login company name
 	companyName string
 	// Eloqua login user name
@@ -33,10 +36,23 @@ type Client struct {
 	password string
 	// Basic auth header value
 	authHeader string
+	// Authenticator used to authorize each request
+	auth Authenticator
+	// Eloqua endpoint used to discover the instance base URLs
+	loginURL string
+	// User agent sent with each request
+	userAgent string
+	// Default headers sent with each request
+	headers http.Header
+	// Policy for retrying failed requests, Requests are not retried if nil
+	retryPolicy *RetryPolicy
+	// Limiter throttling requests, Requests are not throttled if nil
+	rateLimiter *RateLimiter
 
 	// The service endpoints of the API
 	Accounts           *AccountService
 	Activities         *ActivityService
+	Bulk               *BulkService
 	Campaigns          *CampaignService
 	Contacts           *ContactService
 	ContactFields      *ContactFieldService
@@ -45,7 +61,9 @@ type Client struct {
 	ContentSections    *ContentSectionService
 	CustomObjects      *CustomObjectService
 	CustomObjectData   *CustomObjectDataService
+	Dependencies       *DependencyService
 	Emails             *EmailService
+	EmailDeployments   *EmailDeploymentService
 	EmailFolders       *EmailFolderService
 	EmailGroups        *EmailGroupService
 	EmailHeaders       *EmailHeaderService
@@ -61,27 +79,48 @@ type Client struct {
 	OptionLists        *OptionListService
 	Users              *UserService
 	Visitors           *VisitorService
+
+	// Cached field definitions for reading & writing field values by name
+	Fields *FieldRegistry
 }
 
 // NewClient creates a new instance of an Eloqua HTTP client
 // used to interface with the Eloqua API.
-func NewClient(baseURL string, companyName string, userName string, password string) *Client {
+// Requests are authenticated using basic authentication.
+// Options can be provided to customise the underlying HTTP client & requests.
+func NewClient(baseURL string, companyName string, userName string, password string, opts ...ClientOption) *Client {
+	auth := NewBasicAuthenticator(companyName, userName, password)
+
+	c := NewClientWithAuthenticator(baseURL, auth, opts...)
+	c.companyName = companyName
+	c.userName = userName
+	c.password = password
+	c.authHeader = auth.header
 
-	authString := companyName + "\\" + userName + ":" + password
-	encodedAuth := base64.StdEncoding.EncodeToString([]byte(authString))
+	return c
+}
 
+// NewClientWithAuthenticator creates a new instance of an Eloqua HTTP client
+// that uses the given Authenticator, such as an OAuthAuthenticator, to authorize requests.
+// A nil Authenticator sends requests without any credentials.
+func NewClientWithAuthenticator(baseURL string, auth Authenticator, opts ...ClientOption) *Client {
 	c := &Client{
-		client:      http.DefaultClient,
-		BaseURL:     strings.Trim(baseURL, " /"),
-		companyName: companyName,
-		userName:    userName,
-		password:    password,
-		authHeader:  "Basic " + encodedAuth,
+		client:    http.DefaultClient,
+		BaseURL:   strings.Trim(baseURL, " /"),
+		auth:      auth,
+		userAgent: defaultUserAgent,
+		headers:   http.Header{},
+		loginURL:  defaultLoginURL,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
 
 	// Create services
 	c.Accounts = &AccountService{client: c}
 	c.Activities = &ActivityService{client: c}
+	c.Bulk = &BulkService{client: c}
 	c.Campaigns = &CampaignService{client: c}
 	c.Contacts = &ContactService{client: c}
 	c.ContactFields = &ContactFieldService{client: c}
@@ -90,7 +129,9 @@ func NewClient(baseURL string, companyName string, userName string, password str
 	c.ContentSections = &ContentSectionService{client: c}
 	c.CustomObjects = &CustomObjectService{client: c}
 	c.CustomObjectData = &CustomObjectDataService{client: c}
+	c.Dependencies = &DependencyService{client: c}
 	c.Emails = &EmailService{client: c}
+	c.EmailDeployments = &EmailDeploymentService{client: c}
 	c.EmailFolders = &EmailFolderService{client: c}
 	c.EmailGroups = &EmailGroupService{client: c}
 	c.EmailHeaders = &EmailHeaderService{client: c}
@@ -107,6 +148,8 @@ func NewClient(baseURL string, companyName string, userName string, password str
 	c.Users = &UserService{client: c}
 	c.Visitors = &VisitorService{client: c}
 
+	c.Fields = &FieldRegistry{client: c}
+
 	return c
 }
 
@@ -155,13 +198,99 @@ type ListOptions struct {
 	// The field on which to order results
 	OrderBy string `url:"orderBy,omitempty"`
 	// A minimum last updated timestamp
-	LastUpdatedAt int `url:"lastUpdatedAt,omitempty"`
+	LastUpdatedAt Timestamp `url:"lastUpdatedAt,omitempty"`
+}
+
+// newRequest creates a HTTP request with a JSON string body, Setting the client's
+// default headers, user agent & authentication details.
+func (c *Client) newRequest(ctx context.Context, method string, url string, jsonData string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), url, bytes.NewBufferString(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.auth != nil {
+		if err := c.auth.Authenticate(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// do sends a request with the given JSON string body, Retrying it according
+// to the client's retry policy. The request is rebuilt for each attempt so the
+// body is replayed in full. A nil Response is returned if the request could not be created.
+func (c *Client) do(ctx context.Context, method string, url string, jsonData string) (*Response, error) {
+	method = strings.ToUpper(method)
+
+	for attempt := 0; ; attempt++ {
+		req, err := c.newRequest(ctx, method, url, jsonData)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.send(ctx, req)
+
+		wait, retry := c.retryPolicy.shouldRetry(ctx, method, attempt, resp, err)
+		if !retry {
+			return newResponse(resp), err
+		}
+
+		// Discard the failed response before trying again
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return newResponse(nil), err
+		}
+	}
+}
+
+// send performs a single HTTP request, Waiting for the client's rate limiter if set.
+// The request holds its concurrency slot until the response body is closed.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.rateLimiter == nil {
+		return c.client.Do(req)
+	}
+
+	release, err := c.rateLimiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
 }
 
 // RestRequest provides a generic way to make a request to the Eloqua API.
 // It's very general but simple performs much of the boilerplate request actions such
 // as setting the correct api url and adding auth headers.
 func (c *Client) RestRequest(endpoint string, method string, jsonData string) (*Response, error) {
+	return c.RestRequestWithContext(context.Background(), endpoint, method, jsonData)
+}
+
+// RestRequestWithContext is like RestRequest but performs the request with the given context.
+// The request is cancelled when the context is cancelled or its deadline passes.
+func (c *Client) RestRequestWithContext(ctx context.Context, endpoint string, method string, jsonData string) (*Response, error) {
 	url := c.BaseURL
 	endpoint = strings.Trim(endpoint, " /")
 
@@ -174,38 +303,24 @@ func (c *Client) RestRequest(endpoint string, method string, jsonData string) (*
 
 	url += endpoint
 
-	// fmt.Println(jsonData)
-	jsonStr := []byte(jsonData)
-	req, err := http.NewRequest(strings.ToUpper(method), url, bytes.NewBuffer(jsonStr))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", c.authHeader)
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	return newResponse(resp), err
+	return c.do(ctx, method, url, jsonData)
 }
 
 // CustomJSONRequest performs a HTTP request with a JSON string body to any endpoint
 // with Eloqua authentication. The client base url is not used here, allowing a completely
 // custom endpoint to be used when required.
 func (c *Client) CustomJSONRequest(endpoint string, method string, jsonData string) (*Response, error) {
+	return c.CustomJSONRequestWithContext(context.Background(), endpoint, method, jsonData)
+}
+
+// CustomJSONRequestWithContext is like CustomJSONRequest but performs the request with the given context.
+func (c *Client) CustomJSONRequestWithContext(ctx context.Context, endpoint string, method string, jsonData string) (*Response, error) {
 	url := endpoint
 
-	// fmt.Println(jsonData)
-	jsonStr := []byte(jsonData)
-	req, err := http.NewRequest(strings.ToUpper(method), url, bytes.NewBuffer(jsonStr))
-	if err != nil {
+	elqResp, err := c.do(ctx, method, url, jsonData)
+	if elqResp == nil {
 		return nil, err
 	}
-
-	req.Header.Add("Authorization", c.authHeader)
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	elqResp := newResponse(resp);
 	if err == nil {
 		err = checkResponse(elqResp)
 	}
@@ -213,9 +328,9 @@ func (c *Client) CustomJSONRequest(endpoint string, method string, jsonData stri
 }
 
 // Performs a GET request and decodes the response into the provided interface
-func (c *Client) getRequestDecode(endpoint string, v interface{}) (*Response, error) {
-	resp, err := c.RestRequest(endpoint, "GET", "")
-	if resp != nil {
+func (c *Client) getRequestDecode(ctx context.Context, endpoint string, v interface{}) (*Response, error) {
+	resp, err := c.RestRequestWithContext(ctx, endpoint, "GET", "")
+	if resp != nil && resp.Response != nil {
 		defer resp.Body.Close()
 	}
 	if err != nil {
@@ -238,7 +353,7 @@ func (c *Client) getRequestDecode(endpoint string, v interface{}) (*Response, er
 }
 
 // Performs a GET request for a listing endpoint and decodes the response into the provided interface
-func (c *Client) getRequestListDecode(endpoint string, v interface{}, opts *ListOptions) (*Response, error) {
+func (c *Client) getRequestListDecode(ctx context.Context, endpoint string, v interface{}, opts *ListOptions) (*Response, error) {
 
 	// Create our options if not set
 	if opts == nil {
@@ -252,9 +367,9 @@ func (c *Client) getRequestListDecode(endpoint string, v interface{}, opts *List
 	encoder, _ := query.Values(opts)
 	endpoint += "?" + encoder.Encode()
 
-	resp, err := c.RestRequest(endpoint, "GET", "")
+	resp, err := c.RestRequestWithContext(ctx, endpoint, "GET", "")
 
-	if resp != nil {
+	if resp != nil && resp.Response != nil {
 		defer resp.Body.Close()
 	}
 
@@ -280,22 +395,33 @@ func (c *Client) getRequestListDecode(endpoint string, v interface{}, opts *List
 	return resp, err
 }
 
-// Performs a HTTP request using the given method
+// RequestDecode performs a HTTP request using the given method
 // and decodes the response into the provided interface
 func (c *Client) RequestDecode(endpoint string, method string, v interface{}) (*Response, error) {
+	return c.RequestDecodeWithContext(context.Background(), endpoint, method, v)
+}
+
+// RequestDecodeWithContext is like RequestDecode but performs the request with the given context.
+func (c *Client) RequestDecodeWithContext(ctx context.Context, endpoint string, method string, v interface{}) (*Response, error) {
+	return c.requestDecodeInto(ctx, endpoint, method, v, v)
+}
+
+// requestDecodeInto performs a HTTP request using the given method with body
+// encoded as JSON, And decodes the response into v
+func (c *Client) requestDecodeInto(ctx context.Context, endpoint string, method string, body interface{}, v interface{}) (*Response, error) {
 
 	postBody := ""
 
-	if v != nil {
-		jsonString, err := json.Marshal(v)
+	if body != nil {
+		jsonString, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
 		postBody = string(jsonString)
 	}
 
-	resp, err := c.RestRequest(endpoint, strings.ToUpper(method), postBody)
-	if resp != nil {
+	resp, err := c.RestRequestWithContext(ctx, endpoint, strings.ToUpper(method), postBody)
+	if resp != nil && resp.Response != nil {
 		defer resp.Body.Close()
 	}
 
@@ -319,17 +445,17 @@ func (c *Client) RequestDecode(endpoint string, method string, v interface{}) (*
 }
 
 // Performs a POST request and decodes the response into the provided interface
-func (c *Client) postRequestDecode(endpoint string, v interface{}) (*Response, error) {
-	return c.RequestDecode(endpoint, "POST", v)
+func (c *Client) postRequestDecode(ctx context.Context, endpoint string, v interface{}) (*Response, error) {
+	return c.RequestDecodeWithContext(ctx, endpoint, "POST", v)
 }
 
 // Performs a PUT request and decodes the response into the provided interface
-func (c *Client) putRequestDecode(endpoint string, v interface{}) (*Response, error) {
-	return c.RequestDecode(endpoint, "PUT", v)
+func (c *Client) putRequestDecode(ctx context.Context, endpoint string, v interface{}) (*Response, error) {
+	return c.RequestDecodeWithContext(ctx, endpoint, "PUT", v)
 }
 
 // Performs a DELETE request to the provided endpoint, sending the provided interface data.
-func (c *Client) deleteRequest(endpoint string, v interface{}) (*Response, error) {
+func (c *Client) deleteRequest(ctx context.Context, endpoint string, v interface{}) (*Response, error) {
 	postBody := ""
 
 	if v != nil {
@@ -340,7 +466,13 @@ func (c *Client) deleteRequest(endpoint string, v interface{}) (*Response, error
 		postBody = string(jsonString)
 	}
 
-	resp, err := c.RestRequest(endpoint, "DELETE", postBody)
+	resp, err := c.RestRequestWithContext(ctx, endpoint, "DELETE", postBody)
+	if err != nil {
+		return resp, err
+	}
+	if resp.Response != nil {
+		defer resp.Body.Close()
+	}
 	err = checkResponse(resp)
 
 	return resp, err
@@ -380,7 +512,7 @@ var errorMessages = map[int]string{
 }
 
 // checkResponse checks the Eloqua response for errors
-// and returns them in a descriptive way if possible.
+// and returns them as an *ErrorResponse if found.
 func checkResponse(r *Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
@@ -391,11 +523,7 @@ func checkResponse(r *Response) error {
 		r.ErrorContent = string(content)
 	}
 
-	if message, ok := errorMessages[r.StatusCode]; ok {
-		return errors.New(message)
-	}
-
-	return errors.New("There was an issue performing your request")
+	return newErrorResponse(r.Response, r.ErrorContent)
 }
 
 // HTMLContent represents the htmlContent model of an Eloqua email or landing page object
@@ -431,7 +559,7 @@ type FieldMerge struct {
 	Name                  string                `json:"name,omitempty"`
 	FolderID              int                   `json:"folderId,omitempty,string"`
 	Syntax                string                `json:"syntax,omitempty"`
-	UpdatedAt             int                   `json:"updatedAt,omitempty,string"`
+	UpdatedAt             Timestamp             `json:"updatedAt,omitempty"`
 	UpdatedBy             int                   `json:"updatedBy,omitempty,string"`
 	ContactFieldID        int                   `json:"contactFieldId,omitempty,string"`
 	DefaultValue          string                `json:"defaultValue,omitempty"`
@@ -447,12 +575,14 @@ type DynamicContent struct {
 	Depth       string               `json:"depth,omitempty"`
 	Name        string               `json:"name,omitempty"`
 	FolderID    int                  `json:"folderId,omitempty,string"`
-	UpdatedAt   int                  `json:"updatedAt,omitempty,string"`
+	UpdatedAt   Timestamp            `json:"updatedAt,omitempty"`
 	UpdatedBy   int                  `json:"updatedBy,omitempty,string"`
-	CreatedAt   int                  `json:"createdAt,omitempty,string"`
+	CreatedAt   Timestamp            `json:"createdAt,omitempty"`
 	CreatedBy   int                  `json:"createdBy,omitempty,string"`
 	Permissions []string             `json:"permissions,omitempty"`
 	Rules       []DynamicContentRule `json:"rules,omitempty"`
+
+	DefaultContentSection DynamicContentSection `json:"defaultContentSection,omitempty"`
 }
 
 // DynamicContentSection represents the 'section' of content of an Eloqua dynmaic content object.
@@ -463,9 +593,9 @@ type DynamicContentSection struct {
 	Name        string      `json:"name,omitempty"`
 	FolderID    int         `json:"folderId,omitempty,string"`
 	Permissions []string    `json:"permissions,omitempty"`
-	UpdatedAt   int         `json:"updatedAt,omitempty,string"`
+	UpdatedAt   Timestamp   `json:"updatedAt,omitempty"`
 	UpdatedBy   int         `json:"updatedBy,omitempty,string"`
-	CreatedAt   int         `json:"createdAt,omitempty,string"`
+	CreatedAt   Timestamp   `json:"createdAt,omitempty"`
 	CreatedBy   int         `json:"createdBy,omitempty,string"`
 	ContentHTML string      `json:"contentHtml,omitempty"`
 	ContentText string      `json:"contentText,omitempty"`
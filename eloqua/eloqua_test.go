@@ -1,12 +1,15 @@
 package eloqua
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 	"io/ioutil"
 )
 
@@ -38,6 +41,10 @@ func addRestHandlerFunc(endpoint string, handler func(http.ResponseWriter, *http
 	mux.HandleFunc("/api/rest/2.0/"+strings.Trim(endpoint, " /"), handler)
 }
 
+func addBulkHandlerFunc(endpoint string, handler func(http.ResponseWriter, *http.Request)) {
+	mux.HandleFunc("/api/bulk/2.0/"+strings.Trim(endpoint, " /"), handler)
+}
+
 func addLegacyRestHandlerFunc(endpoint string, handler func(http.ResponseWriter, *http.Request)) {
 	mux.HandleFunc("/api/rest/1.0/"+strings.Trim(endpoint, " /"), handler)
 }
@@ -97,13 +104,13 @@ func TestRestRequestErrorHandling(t *testing.T) {
 func TestGetRequestDecodeErrorHandling(t *testing.T) {
 	setup()
 	defer teardown()
-	_, err := client.getRequestDecode("/%2///F a", nil)
+	_, err := client.getRequestDecode(context.Background(), "/%2///F a", nil)
 
 	if err == nil {
 		t.Error("Request expected to return error due to bad url format")
 	}
 
-	_, err = client.getRequestDecode("/a/non-existing/endpoint", nil)
+	_, err = client.getRequestDecode(context.Background(), "/a/non-existing/endpoint", nil)
 	if err == nil {
 		t.Error("Request expected to return error due to 404 response")
 	}
@@ -113,7 +120,7 @@ func TestGetRequestDecodeErrorHandling(t *testing.T) {
 		fmt.Fprint(w, "")
 	})
 	testModel := &ContactList{}
-	_, err = client.getRequestDecode("/assets/contact/lists", testModel)
+	_, err = client.getRequestDecode(context.Background(), "/assets/contact/lists", testModel)
 
 	if err != nil {
 		t.Error("Empty response should not cause EOF error an error was returned")
@@ -124,7 +131,7 @@ func TestGetRequestDecodeErrorHandling(t *testing.T) {
 func TestGetRequestListDecodeErrorHandling(t *testing.T) {
 	setup()
 	defer teardown()
-	_, err := client.getRequestListDecode("/%2///F a", nil, nil)
+	_, err := client.getRequestListDecode(context.Background(), "/%2///F a", nil, nil)
 
 	if err == nil {
 		t.Error("Request expected to return error due to bad url format")
@@ -175,7 +182,7 @@ func TestDeleteRequestErrorHandling(t *testing.T) {
 	defer teardown()
 
 	user := User{Name: "Test User"}
-	_, err := client.deleteRequest("/test/endpoint", user)
+	_, err := client.deleteRequest(context.Background(), "/test/endpoint", user)
 
 	if err == nil {
 		t.Error("Request did not return an error but a 404 was expected")
@@ -187,7 +194,7 @@ func TestDeleteRequestJSONErrorHandling(t *testing.T) {
 	defer teardown()
 
 	tMap := make(chan int)
-	_, err := client.deleteRequest("/test/endpoint", tMap)
+	_, err := client.deleteRequest(context.Background(), "/test/endpoint", tMap)
 
 	if err.Error() != "json: unsupported type: chan int" {
 		t.Error("Delete request with invalid postdata not returning an error as expected")
@@ -265,4 +272,58 @@ func TestCustomJSONRequest(t *testing.T) {
 	if err == nil {
 		t.Error("Expected http request error due to invalid url but no error was received")
 	}
-}
\ No newline at end of file
+}
+func TestRestRequestWithContextCancelled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("Request was sent to the server although the context was cancelled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.RestRequestWithContext(ctx, "/data/contacts", "GET", "")
+	if err == nil {
+		t.Error("Expected an error due to a cancelled context but no error was returned")
+	}
+
+	_, err = client.RequestDecodeWithContext(ctx, "/data/contacts", "GET", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, Recieved: %v", err)
+	}
+
+	_, err = client.deleteRequest(ctx, "/data/contacts", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, Recieved: %v", err)
+	}
+
+	_, err = client.CustomJSONRequestWithContext(ctx, server.URL+"/api/rest/2.0/data/contacts", "GET", "")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, Recieved: %v", err)
+	}
+}
+
+func TestRequestWithContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	block := make(chan struct{})
+	defer close(block)
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-block:
+		case <-req.Context().Done():
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Contacts.ListWithContext(ctx, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+}
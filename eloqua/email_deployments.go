@@ -0,0 +1,172 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EmailDeploymentService provides access to the endpoints used
+// to send emails from eloqua outside of a campaign
+type EmailDeploymentService struct {
+	client *Client
+}
+
+// Email deployment types
+const (
+	EmailTestDeployment      = "EmailTestDeployment"
+	EmailLowVolumeDeployment = "EmailLowVolumeDeployment"
+)
+
+// Email deployment statuses while the email is still being sent
+const (
+	EmailDeploymentPending = "pending"
+	EmailDeploymentSending = "sending"
+)
+
+// defaultDeploymentPollInterval is used when waiting for a deployment without a positive poll interval.
+const defaultDeploymentPollInterval = 5 * time.Second
+
+// EmailDeployment represents a single send of an Eloqua email.
+// Test deployments send to a single contact, Set by ContactID, While
+// low volume deployments send to each of the contacts in ContactIDs.
+type EmailDeployment struct {
+	Type          string    `json:"type,omitempty"`
+	CurrentStatus string    `json:"currentStatus,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy     int       `json:"updatedBy,omitempty,string"`
+
+	Email               *Email    `json:"email,omitempty"`
+	ContactID           int       `json:"contactId,omitempty,string"`
+	ContactIDs          []string  `json:"contactIds,omitempty"`
+	SendFromUserID      int       `json:"sendFromUserId,omitempty,string"`
+	SentAt              Timestamp `json:"sentAt,omitempty"`
+	SuccessfulSendCount int       `json:"successfulSendCount,omitempty,string"`
+	FailedSendCount     int       `json:"failedSendCount,omitempty,string"`
+}
+
+// IsComplete reports whether the deployment has finished sending, Successfully or not.
+func (d *EmailDeployment) IsComplete() bool {
+	status := strings.ToLower(d.CurrentStatus)
+	return status != "" && status != EmailDeploymentPending && status != EmailDeploymentSending
+}
+
+// Create a new email deployment in eloqua, Sending its email
+func (e *EmailDeploymentService) Create(deployment *EmailDeployment) (*EmailDeployment, *Response, error) {
+	return e.CreateWithContext(context.Background(), deployment)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailDeploymentService) CreateWithContext(ctx context.Context, deployment *EmailDeployment) (*EmailDeployment, *Response, error) {
+	if deployment == nil || deployment.Email == nil || deployment.Email.ID == 0 {
+		return nil, nil, fmt.Errorf("Email deployment requires an email with an ID")
+	}
+
+	endpoint := "/assets/email/deployment"
+	resp, err := e.client.postRequestDecode(ctx, endpoint, deployment)
+	return deployment, resp, err
+}
+
+// Get an email deployment object via its ID
+func (e *EmailDeploymentService) Get(id int) (*EmailDeployment, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailDeploymentService) GetWithContext(ctx context.Context, id int) (*EmailDeployment, *Response, error) {
+	endpoint := fmt.Sprintf("/assets/email/deployment/%d", id)
+	deployment := &EmailDeployment{}
+	resp, err := e.client.getRequestDecode(ctx, endpoint, deployment)
+	return deployment, resp, err
+}
+
+// SendTest sends the email with the given ID to each of the given test contacts.
+// Eloqua test deployments send to a single contact, So a deployment is created for each contact.
+// Sending stops at the first error, Returning the deployments created so far.
+func (e *EmailDeploymentService) SendTest(emailID int, contactIDs ...int) ([]*EmailDeployment, *Response, error) {
+	return e.SendTestWithContext(context.Background(), emailID, contactIDs...)
+}
+
+// SendTestWithContext is like SendTest but performs the requests with the given context.
+func (e *EmailDeploymentService) SendTestWithContext(ctx context.Context, emailID int, contactIDs ...int) ([]*EmailDeployment, *Response, error) {
+	if len(contactIDs) == 0 {
+		return nil, nil, fmt.Errorf("At least one contact is required to send a test email")
+	}
+
+	var resp *Response
+	deployments := make([]*EmailDeployment, 0, len(contactIDs))
+	for _, contactID := range contactIDs {
+		deployment := &EmailDeployment{
+			Type:      EmailTestDeployment,
+			Name:      fmt.Sprintf("Test send of email %d to contact %d", emailID, contactID),
+			Email:     &Email{ID: emailID},
+			ContactID: contactID,
+		}
+
+		var err error
+		deployment, resp, err = e.CreateWithContext(ctx, deployment)
+		if err != nil {
+			return deployments, resp, err
+		}
+		deployments = append(deployments, deployment)
+	}
+
+	return deployments, resp, nil
+}
+
+// SendLowVolume sends the email with the given ID to each of the given contacts in a single deployment.
+func (e *EmailDeploymentService) SendLowVolume(emailID int, contactIDs []int) (*EmailDeployment, *Response, error) {
+	return e.SendLowVolumeWithContext(context.Background(), emailID, contactIDs)
+}
+
+// SendLowVolumeWithContext is like SendLowVolume but performs the request with the given context.
+func (e *EmailDeploymentService) SendLowVolumeWithContext(ctx context.Context, emailID int, contactIDs []int) (*EmailDeployment, *Response, error) {
+	if len(contactIDs) == 0 {
+		return nil, nil, fmt.Errorf("At least one contact is required to send an email")
+	}
+
+	ids := make([]string, len(contactIDs))
+	for i, id := range contactIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+
+	deployment := &EmailDeployment{
+		Type:       EmailLowVolumeDeployment,
+		Name:       fmt.Sprintf("Send of email %d to %d contacts", emailID, len(contactIDs)),
+		Email:      &Email{ID: emailID},
+		ContactIDs: ids,
+	}
+	return e.CreateWithContext(ctx, deployment)
+}
+
+// WaitForDeployment polls the deployment with the given ID at the given interval until it has completed,
+// Polling every 5 seconds if the interval is not positive.
+// The completed deployment is returned, Its send counts should be checked for failures.
+func (e *EmailDeploymentService) WaitForDeployment(id int, pollInterval time.Duration) (*EmailDeployment, *Response, error) {
+	return e.WaitForDeploymentWithContext(context.Background(), id, pollInterval)
+}
+
+// WaitForDeploymentWithContext is like WaitForDeployment but performs the requests with the given context.
+// Polling stops when the context is cancelled or its deadline passes.
+func (e *EmailDeploymentService) WaitForDeploymentWithContext(ctx context.Context, id int, pollInterval time.Duration) (*EmailDeployment, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultDeploymentPollInterval
+	}
+
+	for {
+		deployment, resp, err := e.GetWithContext(ctx, id)
+		if err != nil || deployment.IsComplete() {
+			return deployment, resp, err
+		}
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return deployment, resp, err
+		}
+	}
+}
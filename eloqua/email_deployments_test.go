@@ -0,0 +1,136 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestEmailDeploymentSendTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var received []int
+	addRestHandlerFunc("/assets/email/deployment", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(EmailDeployment)
+		json.NewDecoder(req.Body).Decode(v)
+
+		if v.Type != EmailTestDeployment || v.Email == nil || v.Email.ID != 40 {
+			t.Errorf("EmailDeployments.SendTest body not as expected, Recieved: %+v", v)
+		}
+		received = append(received, v.ContactID)
+
+		if v.ContactID == 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"type":"EmailTestDeployment","id":"%d","contactId":"%d","currentStatus":"pending","email":{"type":"Email","id":"40"}}`, 100+v.ContactID, v.ContactID)
+	})
+
+	deployments, _, err := client.EmailDeployments.SendTest(40, 1, 2)
+	if err != nil {
+		t.Fatalf("EmailDeployments.SendTest recieved error: %v", err)
+	}
+
+	want := []*EmailDeployment{
+		{Type: EmailTestDeployment, ID: 101, Name: "Test send of email 40 to contact 1", ContactID: 1, CurrentStatus: "pending", Email: &Email{Type: "Email", ID: 40}},
+		{Type: EmailTestDeployment, ID: 102, Name: "Test send of email 40 to contact 2", ContactID: 2, CurrentStatus: "pending", Email: &Email{Type: "Email", ID: 40}},
+	}
+	testModels(t, "EmailDeployments.SendTest", deployments, want)
+
+	deployments, _, err = client.EmailDeployments.SendTest(40, 2, 3, 4)
+	if err == nil {
+		t.Error("EmailDeployments.SendTest expected error for a failed send")
+	}
+	if len(deployments) != 1 {
+		t.Errorf("EmailDeployments.SendTest expected the successful deployment, Recieved: %+v", deployments)
+	}
+	testModels(t, "EmailDeployments.SendTest contacts", received, []int{1, 2, 2, 3})
+}
+
+func TestEmailDeploymentSendLowVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/email/deployment", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "POST")
+		v := new(EmailDeployment)
+		json.NewDecoder(req.Body).Decode(v)
+
+		want := &EmailDeployment{
+			Type:       EmailLowVolumeDeployment,
+			Name:       "Send of email 40 to 2 contacts",
+			Email:      &Email{ID: 40},
+			ContactIDs: []string{"7", "8"},
+		}
+		testModels(t, "EmailDeployments.SendLowVolume body", v, want)
+
+		fmt.Fprint(w, `{"type":"EmailLowVolumeDeployment","id":"200","currentStatus":"pending","contactIds":["7","8"]}`)
+	})
+
+	deployment, _, err := client.EmailDeployments.SendLowVolume(40, []int{7, 8})
+	if err != nil {
+		t.Fatalf("EmailDeployments.SendLowVolume recieved error: %v", err)
+	}
+	if deployment.ID != 200 || deployment.IsComplete() {
+		t.Errorf("EmailDeployments.SendLowVolume not as expected, Recieved: %+v", deployment)
+	}
+
+	if _, _, err := client.EmailDeployments.SendLowVolume(40, nil); err == nil {
+		t.Error("EmailDeployments.SendLowVolume expected error without contacts")
+	}
+}
+
+func TestEmailDeploymentWaitForDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	addRestHandlerFunc("/assets/email/deployment/200", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		polls++
+		status := "sending"
+		if polls == 3 {
+			status = "sent"
+		}
+		fmt.Fprintf(w, `{"type":"EmailLowVolumeDeployment","id":"200","currentStatus":"%s","successfulSendCount":"2","failedSendCount":"1"}`, status)
+	})
+
+	deployment, _, err := client.EmailDeployments.WaitForDeployment(200, time.Millisecond)
+	if err != nil {
+		t.Fatalf("EmailDeployments.WaitForDeployment recieved error: %v", err)
+	}
+
+	want := &EmailDeployment{Type: EmailLowVolumeDeployment, ID: 200, CurrentStatus: "sent", SuccessfulSendCount: 2, FailedSendCount: 1}
+	testModels(t, "EmailDeployments.WaitForDeployment", deployment, want)
+
+	if polls != 3 {
+		t.Errorf("EmailDeployments.WaitForDeployment expected 3 polls, Recieved: %d", polls)
+	}
+}
+
+func TestEmailDeploymentWaitForDeploymentDefaultInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	addRestHandlerFunc("/assets/email/deployment/200", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		fmt.Fprint(w, `{"type":"EmailLowVolumeDeployment","id":"200","currentStatus":"sending"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.EmailDeployments.WaitForDeploymentWithContext(ctx, 200, 0)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+	if polls != 1 {
+		t.Errorf("Expected a single poll using the default interval, Recieved %d polls", polls)
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,50 +15,80 @@ type EmailFolderService struct {
 
 // EmailFolder represents an Eloqua email folder object.
 type EmailFolder struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	FolderID    int    `json:"folderId,omitempty,string"`
-	UpdatedAt   int    `json:"updatedAt,omitempty,string"`
-	UpdatedBy   int    `json:"updatedBy,omitempty,string"`
-	IsSystem    bool   `json:"isSystem,omitempty,string"`
-	Archive     bool   `json:"archive,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	Depth     string    `json:"depth,omitempty"`
+
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	FolderID    int       `json:"folderId,omitempty,string"`
+	UpdatedAt   Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy   int       `json:"updatedBy,omitempty,string"`
+	IsSystem    bool      `json:"isSystem,omitempty,string"`
+	Archive     bool      `json:"archive,omitempty,string"`
 }
 
 // Create a new email folder in eloqua
 func (e *EmailFolderService) Create(name string, emailFolder *EmailFolder) (*EmailFolder, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, emailFolder)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailFolderService) CreateWithContext(ctx context.Context, name string, emailFolder *EmailFolder) (*EmailFolder, *Response, error) {
 	if emailFolder == nil {
 		emailFolder = &EmailFolder{}
 	}
 	emailFolder.Name = name
 
 	endpoint := "/assets/email/folder"
-	resp, err := e.client.postRequestDecode(endpoint, emailFolder)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, emailFolder)
 	return emailFolder, resp, err
 }
 
 // Get an email folder object via its ID
 func (e *EmailFolderService) Get(id int) (*EmailFolder, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailFolderService) GetWithContext(ctx context.Context, id int) (*EmailFolder, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/email/folder/%d?depth=complete", id)
 	emailFolder := &EmailFolder{}
-	resp, err := e.client.getRequestDecode(endpoint, emailFolder)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, emailFolder)
 	return emailFolder, resp, err
 }
 
 // List many eloqua email folders
 func (e *EmailFolderService) List(opts *ListOptions) ([]EmailFolder, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *EmailFolderService) ListWithContext(ctx context.Context, opts *ListOptions) ([]EmailFolder, *Response, error) {
 	endpoint := "/assets/email/folders"
 	emailFolders := new([]EmailFolder)
-	resp, err := e.client.getRequestListDecode(endpoint, emailFolders, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, emailFolders, opts)
 	return *emailFolders, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua email folders
+func (e *EmailFolderService) ListPager(opts *ListOptions) *Pager[EmailFolder] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua email folders, Requesting each page in turn
+func (e *EmailFolderService) ListAll(ctx context.Context, opts *ListOptions) ([]EmailFolder, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing email folder in eloqua
 func (e *EmailFolderService) Update(id int, name string, emailFolder *EmailFolder) (*EmailFolder, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, emailFolder)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *EmailFolderService) UpdateWithContext(ctx context.Context, id int, name string, emailFolder *EmailFolder) (*EmailFolder, *Response, error) {
 	if emailFolder == nil {
 		emailFolder = &EmailFolder{}
 	}
@@ -66,14 +97,19 @@ func (e *EmailFolderService) Update(id int, name string, emailFolder *EmailFolde
 	emailFolder.Name = name
 
 	endpoint := fmt.Sprintf("/assets/email/folder/%d", emailFolder.ID)
-	resp, err := e.client.putRequestDecode(endpoint, emailFolder)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, emailFolder)
 	return emailFolder, resp, err
 }
 
 // Delete an existing email folder from eloqua
 func (e *EmailFolderService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *EmailFolderService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	emailFolder := &EmailFolder{ID: id}
 	endpoint := fmt.Sprintf("/assets/email/folder/%d", emailFolder.ID)
-	resp, err := e.client.deleteRequest(endpoint, emailFolder)
+	resp, err := e.client.deleteRequest(ctx, endpoint, emailFolder)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,18 +17,18 @@ type EmailFooterService struct {
 
 // EmailFooter represents an Eloqua email footer object.
 type EmailFooter struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	CreatedBy int    `json:"createdBy,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-
-	Name        string   `json:"name,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Permissions []string `json:"permissions,omitempty"`
-	FolderID    int      `json:"folderId,omitempty,string"`
-	UpdatedAt   int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy   int      `json:"updatedBy,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	CreatedBy int       `json:"createdBy,omitempty,string"`
+	Depth     string    `json:"depth,omitempty"`
+
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Permissions []string  `json:"permissions,omitempty"`
+	FolderID    int       `json:"folderId,omitempty,string"`
+	UpdatedAt   Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy   int       `json:"updatedBy,omitempty,string"`
 
 	Body                string `json:"body,omitempty"`
 	PlainText           string `json:"plainText,omitempty"`
@@ -40,34 +41,64 @@ type EmailFooter struct {
 
 // Create a new email footer in eloqua
 func (e *EmailFooterService) Create(name string, emailFooter *EmailFooter) (*EmailFooter, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, emailFooter)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailFooterService) CreateWithContext(ctx context.Context, name string, emailFooter *EmailFooter) (*EmailFooter, *Response, error) {
 	if emailFooter == nil {
 		emailFooter = &EmailFooter{}
 	}
 	emailFooter.Name = name
 
 	endpoint := "/assets/email/footer"
-	resp, err := e.client.postRequestDecode(endpoint, emailFooter)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, emailFooter)
 	return emailFooter, resp, err
 }
 
 // Get an email footer object via its ID
 func (e *EmailFooterService) Get(id int) (*EmailFooter, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailFooterService) GetWithContext(ctx context.Context, id int) (*EmailFooter, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/email/footer/%d?depth=complete", id)
 	emailFooter := &EmailFooter{}
-	resp, err := e.client.getRequestDecode(endpoint, emailFooter)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, emailFooter)
 	return emailFooter, resp, err
 }
 
 // List many eloqua email footers
 func (e *EmailFooterService) List(opts *ListOptions) ([]EmailFooter, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *EmailFooterService) ListWithContext(ctx context.Context, opts *ListOptions) ([]EmailFooter, *Response, error) {
 	endpoint := "/assets/email/footers"
 	emailFooters := new([]EmailFooter)
-	resp, err := e.client.getRequestListDecode(endpoint, emailFooters, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, emailFooters, opts)
 	return *emailFooters, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua email footers
+func (e *EmailFooterService) ListPager(opts *ListOptions) *Pager[EmailFooter] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua email footers, Requesting each page in turn
+func (e *EmailFooterService) ListAll(ctx context.Context, opts *ListOptions) ([]EmailFooter, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing email footer in eloqua
 func (e *EmailFooterService) Update(id int, name string, emailFooter *EmailFooter) (*EmailFooter, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, emailFooter)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *EmailFooterService) UpdateWithContext(ctx context.Context, id int, name string, emailFooter *EmailFooter) (*EmailFooter, *Response, error) {
 	if emailFooter == nil {
 		emailFooter = &EmailFooter{}
 	}
@@ -76,14 +107,19 @@ func (e *EmailFooterService) Update(id int, name string, emailFooter *EmailFoote
 	emailFooter.Name = name
 
 	endpoint := fmt.Sprintf("/assets/email/footer/%d", emailFooter.ID)
-	resp, err := e.client.putRequestDecode(endpoint, emailFooter)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, emailFooter)
 	return emailFooter, resp, err
 }
 
 // Delete an existing email footer from eloqua
 func (e *EmailFooterService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *EmailFooterService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	emailFooter := &EmailFooter{ID: id}
 	endpoint := fmt.Sprintf("/assets/email/footer/%d", emailFooter.ID)
-	resp, err := e.client.deleteRequest(endpoint, emailFooter)
+	resp, err := e.client.deleteRequest(ctx, endpoint, emailFooter)
 	return resp, err
 }
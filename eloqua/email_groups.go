@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,19 +15,19 @@ type EmailGroupService struct {
 
 // EmailGroup represents an Eloqua email group object.
 type EmailGroup struct {
-	Type          string   `json:"type,omitempty"`
-	ID            int      `json:"id,omitempty,string"`
-	CreatedAt     int      `json:"createdAt,omitempty,string"`
-	CreatedBy     int      `json:"createdBy,omitempty,string"`
-	Depth         string   `json:"depth,omitempty"`
-	Name          string   `json:"name,omitempty"`
-	Permissions   []string `json:"permissions,omitempty"`
-	Description   string   `json:"description,omitempty"`
-	UpdatedAt     int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy     int      `json:"updatedBy,omitempty,string"`
-	EmailHeaderID int      `json:"emailHeaderId,omitempty,string"`
-	EmailFooterID int      `json:"emailFooterId,omitempty,string"`
-	EmailIDs      []int    `json:"emailIds,omitempty,string"`
+	Type          string    `json:"type,omitempty"`
+	ID            int       `json:"id,omitempty,string"`
+	CreatedAt     Timestamp `json:"createdAt,omitempty"`
+	CreatedBy     int       `json:"createdBy,omitempty,string"`
+	Depth         string    `json:"depth,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	Permissions   []string  `json:"permissions,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	UpdatedAt     Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy     int       `json:"updatedBy,omitempty,string"`
+	EmailHeaderID int       `json:"emailHeaderId,omitempty,string"`
+	EmailFooterID int       `json:"emailFooterId,omitempty,string"`
+	EmailIDs      []int     `json:"emailIds,omitempty,string"`
 
 	IsVisibleInOutlookPlugin          bool   `json:"isVisibleInOutlookPlugin,omitempty,string"`
 	IsVisibleInPublicSubscriptionList bool   `json:"isVisibleInPublicSubscriptionList,omitempty,string"`
@@ -43,37 +44,67 @@ type EmailGroup struct {
 // as this is not as per the documentation it is not required in this method.
 // If you get ObjectValidationError's it may be due to this.
 func (e *EmailGroupService) Create(name string, emailGroup *EmailGroup) (*EmailGroup, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, emailGroup)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailGroupService) CreateWithContext(ctx context.Context, name string, emailGroup *EmailGroup) (*EmailGroup, *Response, error) {
 	if emailGroup == nil {
 		emailGroup = &EmailGroup{}
 	}
 
 	emailGroup.Name = name
 	endpoint := "/assets/email/group"
-	resp, err := e.client.postRequestDecode(endpoint, emailGroup)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, emailGroup)
 	return emailGroup, resp, err
 }
 
 // Get a email group object via its ID
 func (e *EmailGroupService) Get(id int) (*EmailGroup, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailGroupService) GetWithContext(ctx context.Context, id int) (*EmailGroup, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/email/group/%d?depth=complete", id)
 	emailGroup := &EmailGroup{}
-	resp, err := e.client.getRequestDecode(endpoint, emailGroup)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, emailGroup)
 	return emailGroup, resp, err
 }
 
 // List many eloqua email groups
 func (e *EmailGroupService) List(opts *ListOptions) ([]EmailGroup, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *EmailGroupService) ListWithContext(ctx context.Context, opts *ListOptions) ([]EmailGroup, *Response, error) {
 	endpoint := "/assets/email/groups"
 	emailGroups := new([]EmailGroup)
-	resp, err := e.client.getRequestListDecode(endpoint, emailGroups, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, emailGroups, opts)
 	return *emailGroups, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua email groups
+func (e *EmailGroupService) ListPager(opts *ListOptions) *Pager[EmailGroup] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua email groups, Requesting each page in turn
+func (e *EmailGroupService) ListAll(ctx context.Context, opts *ListOptions) ([]EmailGroup, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing email group in eloqua
 // During testing subscriptionLandingPageId & subscriptionLandingPageId seemed to be required but
 // as this is not as per the documentation it is not required in this method.
 // If you get ObjectValidationError's it may be due to this.
 func (e *EmailGroupService) Update(id int, name string, emailGroup *EmailGroup) (*EmailGroup, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, emailGroup)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *EmailGroupService) UpdateWithContext(ctx context.Context, id int, name string, emailGroup *EmailGroup) (*EmailGroup, *Response, error) {
 	if emailGroup == nil {
 		emailGroup = &EmailGroup{}
 	}
@@ -82,14 +113,19 @@ func (e *EmailGroupService) Update(id int, name string, emailGroup *EmailGroup)
 	emailGroup.Name = name
 
 	endpoint := fmt.Sprintf("/assets/email/group/%d", emailGroup.ID)
-	resp, err := e.client.putRequestDecode(endpoint, emailGroup)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, emailGroup)
 	return emailGroup, resp, err
 }
 
 // Delete an existing email group from eloqua
 func (e *EmailGroupService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *EmailGroupService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	emailGroup := &EmailGroup{ID: id}
 	endpoint := fmt.Sprintf("/assets/email/group/%d", emailGroup.ID)
-	resp, err := e.client.deleteRequest(endpoint, emailGroup)
+	resp, err := e.client.deleteRequest(ctx, endpoint, emailGroup)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,58 +15,88 @@ type EmailHeaderService struct {
 
 // EmailHeader represents an Eloqua email header object.
 type EmailHeader struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	CreatedBy int    `json:"createdBy,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-
-	Name        string   `json:"name,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Permissions []string `json:"permissions,omitempty"`
-	FolderID    int      `json:"folderId,omitempty,string"`
-	UpdatedAt   int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy   int      `json:"updatedBy,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	CreatedBy int       `json:"createdBy,omitempty,string"`
+	Depth     string    `json:"depth,omitempty"`
+
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Permissions []string  `json:"permissions,omitempty"`
+	FolderID    int       `json:"folderId,omitempty,string"`
+	UpdatedAt   Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy   int       `json:"updatedBy,omitempty,string"`
 
 	Body                string `json:"body,omitempty"`
 	PlainText           string `json:"plainText,omitempty"`
 	IsPlainTextEditable bool   `json:"isPlainTextEditable,omitempty,string"`
 
-	FieldMerges []FieldMerge `json:"hyperlinks,fieldMerges"`
+	FieldMerges []FieldMerge `json:"fieldMerges,omitempty"`
 	Images      []Image      `json:"images,omitempty"`
 	Hyperlinks  []Hyperlink  `json:"hyperlinks,omitempty"`
 }
 
 // Create a new email header in eloqua
 func (e *EmailHeaderService) Create(name string, emailHeader *EmailHeader) (*EmailHeader, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, emailHeader)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailHeaderService) CreateWithContext(ctx context.Context, name string, emailHeader *EmailHeader) (*EmailHeader, *Response, error) {
 	if emailHeader == nil {
 		emailHeader = &EmailHeader{}
 	}
 	emailHeader.Name = name
 
 	endpoint := "/assets/email/header"
-	resp, err := e.client.postRequestDecode(endpoint, emailHeader)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, emailHeader)
 	return emailHeader, resp, err
 }
 
 // Get an email header object via its ID
 func (e *EmailHeaderService) Get(id int) (*EmailHeader, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailHeaderService) GetWithContext(ctx context.Context, id int) (*EmailHeader, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/email/header/%d?depth=complete", id)
 	emailHeader := &EmailHeader{}
-	resp, err := e.client.getRequestDecode(endpoint, emailHeader)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, emailHeader)
 	return emailHeader, resp, err
 }
 
 // List many eloqua email headers
 func (e *EmailHeaderService) List(opts *ListOptions) ([]EmailHeader, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *EmailHeaderService) ListWithContext(ctx context.Context, opts *ListOptions) ([]EmailHeader, *Response, error) {
 	endpoint := "/assets/email/headers"
 	emailHeaders := new([]EmailHeader)
-	resp, err := e.client.getRequestListDecode(endpoint, emailHeaders, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, emailHeaders, opts)
 	return *emailHeaders, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua email headers
+func (e *EmailHeaderService) ListPager(opts *ListOptions) *Pager[EmailHeader] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua email headers, Requesting each page in turn
+func (e *EmailHeaderService) ListAll(ctx context.Context, opts *ListOptions) ([]EmailHeader, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing email header in eloqua
 func (e *EmailHeaderService) Update(id int, name string, emailHeader *EmailHeader) (*EmailHeader, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, emailHeader)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *EmailHeaderService) UpdateWithContext(ctx context.Context, id int, name string, emailHeader *EmailHeader) (*EmailHeader, *Response, error) {
 	if emailHeader == nil {
 		emailHeader = &EmailHeader{}
 	}
@@ -74,14 +105,19 @@ func (e *EmailHeaderService) Update(id int, name string, emailHeader *EmailHeade
 	emailHeader.Name = name
 
 	endpoint := fmt.Sprintf("/assets/email/header/%d", emailHeader.ID)
-	resp, err := e.client.putRequestDecode(endpoint, emailHeader)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, emailHeader)
 	return emailHeader, resp, err
 }
 
 // Delete an existing email header from eloqua
 func (e *EmailHeaderService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *EmailHeaderService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	emailHeader := &EmailHeader{ID: id}
 	endpoint := fmt.Sprintf("/assets/email/header/%d", emailHeader.ID)
-	resp, err := e.client.deleteRequest(endpoint, emailHeader)
+	resp, err := e.client.deleteRequest(ctx, endpoint, emailHeader)
 	return resp, err
 }
@@ -157,3 +157,31 @@ func TestEmailHeaderDelete(t *testing.T) {
 		t.Error("EmailHeaders.Delete request failed")
 	}
 }
+
+func TestEmailHeaderFieldMergesJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/email/header/1006", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		fmt.Fprint(w, `{"type":"EmailHeader","id":"1006","hyperlinks":[{"type":"Hyperlink","id":"5","href":"https://example.com"}],"fieldMerges":[{"type":"FieldMerge","id":"7","syntax":"FirstName1"}]}`)
+	})
+
+	emailHeader, _, err := client.EmailHeaders.Get(1006)
+	if err != nil {
+		t.Errorf("EmailHeaders.Get recieved error: %v", err)
+	}
+
+	output := &EmailHeader{
+		Type:        "EmailHeader",
+		ID:          1006,
+		Hyperlinks:  []Hyperlink{{Type: "Hyperlink", ID: 5, Href: "https://example.com"}},
+		FieldMerges: []FieldMerge{{Type: "FieldMerge", ID: 7, Syntax: "FirstName1"}},
+	}
+	testModels(t, "EmailHeaders.Get field merges", emailHeader, output)
+
+	b, _ := json.Marshal(&EmailHeader{Name: "No Merges"})
+	if string(b) != `{"name":"No Merges"}` {
+		t.Errorf("EmailHeader JSON not as expected, Recieved: %s", b)
+	}
+}
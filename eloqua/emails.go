@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -17,13 +18,13 @@ type Email struct {
 	Type              string           `json:"type,omitempty"`
 	CurrentStatus     string           `json:"currentStatus,omitempty"`
 	ID                int              `json:"id,omitempty,string"`
-	CreatedAt         int              `json:"createdAt,omitempty,string"`
+	CreatedAt         Timestamp        `json:"createdAt,omitempty"`
 	CreatedBy         int              `json:"createdBy,omitempty,string"`
 	Depth             string           `json:"depth,omitempty"`
 	FolderID          int              `json:"folderId,omitempty,string"`
 	Name              string           `json:"name,omitempty"`
 	Permissions       []string         `json:"permissions,omitempty"`
-	UpdatedAt         int              `json:"updatedAt,omitempty,string"`
+	UpdatedAt         Timestamp        `json:"updatedAt,omitempty"`
 	UpdatedBy         int              `json:"updatedBy,omitempty,string"`
 	BounceBackEmail   string           `json:"bounceBackEmail,omitempty"`
 	ContentSections   []ContentSection `json:"contentSections,omitempty"`
@@ -53,47 +54,82 @@ type Email struct {
 
 // Create a new email in eloqua
 func (e *EmailService) Create(name string, email *Email) (*Email, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, email)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *EmailService) CreateWithContext(ctx context.Context, name string, email *Email) (*Email, *Response, error) {
 	if email == nil {
 		email = &Email{}
 	}
 	email.Name = name
 	endpoint := "/assets/email"
-	resp, err := e.client.postRequestDecode(endpoint, email)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, email)
 	return email, resp, err
 }
 
 // Get an email object via its ID
 func (e *EmailService) Get(id int) (*Email, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *EmailService) GetWithContext(ctx context.Context, id int) (*Email, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/email/%d?depth=complete", id)
 	email := &Email{}
-	resp, err := e.client.getRequestDecode(endpoint, email)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, email)
 	return email, resp, err
 }
 
 // List many Eloqua email objetcs
 func (e *EmailService) List(opts *ListOptions) ([]Email, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *EmailService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Email, *Response, error) {
 	endpoint := "/assets/emails"
 	emails := new([]Email)
-	resp, err := e.client.getRequestListDecode(endpoint, emails, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, emails, opts)
 	return *emails, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua email objects
+func (e *EmailService) ListPager(opts *ListOptions) *Pager[Email] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua email objects, Requesting each page in turn
+func (e *EmailService) ListAll(ctx context.Context, opts *ListOptions) ([]Email, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing email in eloqua
 func (e *EmailService) Update(id int, name string, email *Email) (*Email, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, email)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *EmailService) UpdateWithContext(ctx context.Context, id int, name string, email *Email) (*Email, *Response, error) {
 	if email == nil {
 		email = &Email{}
 	}
 	email.ID = id
 	email.Name = name
 	endpoint := fmt.Sprintf("/assets/email/%d", email.ID)
-	resp, err := e.client.putRequestDecode(endpoint, email)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, email)
 	return email, resp, err
 }
 
 // Delete an existing email from eloqua
 func (e *EmailService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *EmailService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	email := &Email{ID: id}
 	endpoint := fmt.Sprintf("/assets/email/%d", email.ID)
-	resp, err := e.client.deleteRequest(endpoint, email)
+	resp, err := e.client.deleteRequest(ctx, endpoint, email)
 	return resp, err
 }
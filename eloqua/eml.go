@@ -0,0 +1,182 @@
+package eloqua
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EMLStatement is an Eloqua Markup Language statement referencing a field or
+// entity in Bulk API definitions, For example "{{Contact.Field(C_EmailAddress)}}".
+type EMLStatement string
+
+// EMLFilter is an Eloqua Markup Language filter expression used to
+// select the records of a Bulk API export.
+type EMLFilter string
+
+// Common system statements
+const (
+	EMLContactID         EMLStatement = "{{Contact.Id}}"
+	EMLAccountID         EMLStatement = "{{Account.Id}}"
+	EMLActivityID        EMLStatement = "{{Activity.Id}}"
+	EMLActivityType      EMLStatement = "{{Activity.Type}}"
+	EMLActivityDate      EMLStatement = "{{Activity.CreatedAt}}"
+	EMLActivityContact   EMLStatement = "{{Activity.Contact.Id}}"
+	EMLActivityAssetID   EMLStatement = "{{Activity.Asset.Id}}"
+	EMLActivityAssetType EMLStatement = "{{Activity.Asset.Type}}"
+	EMLActivityAssetName EMLStatement = "{{Activity.Asset.Name}}"
+	EMLActivityCampaign  EMLStatement = "{{Activity.Campaign.Id}}"
+)
+
+// ContactFieldStatement references the contact field with the given internal name, Such as "C_EmailAddress".
+func ContactFieldStatement(internalName string) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{Contact.Field(%s)}}", internalName))
+}
+
+// AccountFieldStatement references the account field with the given internal name, Such as "M_CompanyName".
+func AccountFieldStatement(internalName string) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{Account.Field(%s)}}", internalName))
+}
+
+// ActivityFieldStatement references the activity field with the given name, Such as "EmailAddress".
+func ActivityFieldStatement(name string) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{Activity.Field(%s)}}", name))
+}
+
+// CustomObjectFieldStatement references the field with the given fieldID of the custom object with the given cdoID.
+func CustomObjectFieldStatement(cdoID int, fieldID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{CustomObject[%d].Field[%d]}}", cdoID, fieldID))
+}
+
+// CustomObjectContactFieldStatement references a field of the contact linked to a record of
+// the custom object with the given cdoID.
+func CustomObjectContactFieldStatement(cdoID int, internalName string) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{CustomObject[%d].Contact.Field(%s)}}", cdoID, internalName))
+}
+
+// CustomObjectIDStatement references the ID of a record of the custom object with the given cdoID.
+func CustomObjectIDStatement(cdoID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{CustomObject[%d].Id}}", cdoID))
+}
+
+// CustomObjectExternalIDStatement references the external ID of a record of the custom object with the given cdoID.
+func CustomObjectExternalIDStatement(cdoID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{CustomObject[%d].ExternalId}}", cdoID))
+}
+
+// ContactListStatement references the contact list with the given ID,
+// Used as a sync action destination or in membership filters.
+func ContactListStatement(listID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{ContactList[%d]}}", listID))
+}
+
+// ContactSegmentStatement references the segment with the given ID.
+func ContactSegmentStatement(segmentID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{ContactSegment[%d]}}", segmentID))
+}
+
+// ContactFilterStatement references the shared filter with the given ID.
+func ContactFilterStatement(filterID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{ContactFilter[%d]}}", filterID))
+}
+
+// AccountListStatement references the account list with the given ID.
+func AccountListStatement(listID int) EMLStatement {
+	return EMLStatement(fmt.Sprintf("{{AccountList[%d]}}", listID))
+}
+
+// String returns the statement text.
+func (s EMLStatement) String() string {
+	return string(s)
+}
+
+// emlValueEscaper escapes backslashes & apostrophes within quoted EML values,
+// Eloqua expects each to be preceded by a backslash.
+var emlValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// compare builds a filter comparing the statement to value with the given operator.
+// The value is escaped so that it may safely contain apostrophes, For example "O'Brien".
+func (s EMLStatement) compare(operator string, value string) EMLFilter {
+	return EMLFilter(fmt.Sprintf("'%s' %s '%s'", s, operator, emlValueEscaper.Replace(value)))
+}
+
+// Equal filters for records where the statement's value equals value.
+func (s EMLStatement) Equal(value string) EMLFilter {
+	return s.compare("=", value)
+}
+
+// NotEqual filters for records where the statement's value does not equal value.
+func (s EMLStatement) NotEqual(value string) EMLFilter {
+	return s.compare("!=", value)
+}
+
+// GreaterThan filters for records where the statement's value is greater than value.
+func (s EMLStatement) GreaterThan(value string) EMLFilter {
+	return s.compare(">", value)
+}
+
+// GreaterThanOrEqual filters for records where the statement's value is greater than or equal to value.
+func (s EMLStatement) GreaterThanOrEqual(value string) EMLFilter {
+	return s.compare(">=", value)
+}
+
+// LessThan filters for records where the statement's value is less than value.
+func (s EMLStatement) LessThan(value string) EMLFilter {
+	return s.compare("<", value)
+}
+
+// LessThanOrEqual filters for records where the statement's value is less than or equal to value.
+func (s EMLStatement) LessThanOrEqual(value string) EMLFilter {
+	return s.compare("<=", value)
+}
+
+// Like filters for records where the statement's value matches pattern,
+// Which may contain '*' wildcards.
+func (s EMLStatement) Like(pattern string) EMLFilter {
+	return s.compare("~", pattern)
+}
+
+// Exists filters for contacts that are members of the list, segment or filter referenced by the statement.
+func (s EMLStatement) Exists() EMLFilter {
+	return EMLFilter(fmt.Sprintf("EXISTS('%s')", s))
+}
+
+// String returns the filter expression text.
+func (f EMLFilter) String() string {
+	return string(f)
+}
+
+// joinFilters combines filters with the given operator, Wrapping each in parentheses.
+// Empty filters are ignored.
+func joinFilters(operator string, filters []EMLFilter) EMLFilter {
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		if filter != "" {
+			parts = append(parts, string(filter))
+		}
+	}
+	if len(parts) == 1 {
+		return EMLFilter(parts[0])
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return EMLFilter("(" + strings.Join(parts, ") "+operator+" (") + ")")
+}
+
+// EMLAnd combines filters so that records must match all of them.
+func EMLAnd(filters ...EMLFilter) EMLFilter {
+	return joinFilters("AND", filters)
+}
+
+// EMLOr combines filters so that records must match at least one of them.
+func EMLOr(filters ...EMLFilter) EMLFilter {
+	return joinFilters("OR", filters)
+}
+
+// EMLNot negates a filter. An empty filter is returned unchanged.
+func EMLNot(filter EMLFilter) EMLFilter {
+	if filter == "" {
+		return ""
+	}
+	return EMLFilter("NOT (" + string(filter) + ")")
+}
@@ -0,0 +1,74 @@
+package eloqua
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEMLStatements(t *testing.T) {
+	tests := map[EMLStatement]string{
+		ContactFieldStatement("C_EmailAddress"):           "{{Contact.Field(C_EmailAddress)}}",
+		AccountFieldStatement("M_CompanyName"):            "{{Account.Field(M_CompanyName)}}",
+		ActivityFieldStatement("EmailAddress"):            "{{Activity.Field(EmailAddress)}}",
+		CustomObjectFieldStatement(4, 32):                 "{{CustomObject[4].Field[32]}}",
+		CustomObjectContactFieldStatement(4, "C_Country"): "{{CustomObject[4].Contact.Field(C_Country)}}",
+		CustomObjectIDStatement(4):                        "{{CustomObject[4].Id}}",
+		CustomObjectExternalIDStatement(4):                "{{CustomObject[4].ExternalId}}",
+		ContactListStatement(12):                          "{{ContactList[12]}}",
+		ContactSegmentStatement(3):                        "{{ContactSegment[3]}}",
+		ContactFilterStatement(7):                         "{{ContactFilter[7]}}",
+		AccountListStatement(9):                           "{{AccountList[9]}}",
+	}
+
+	for statement, want := range tests {
+		if statement.String() != want {
+			t.Errorf("EML statement not as expected, Expected %s, Recieved %s", want, statement)
+		}
+	}
+}
+
+func TestEMLFilters(t *testing.T) {
+	country := ContactFieldStatement("C_Country")
+	tests := map[EMLFilter]string{
+		country.Equal("UK"):                           "'{{Contact.Field(C_Country)}}' = 'UK'",
+		country.NotEqual("UK"):                        "'{{Contact.Field(C_Country)}}' != 'UK'",
+		EMLActivityDate.GreaterThan("2024-01-01"):     "'{{Activity.CreatedAt}}' > '2024-01-01'",
+		EMLActivityDate.GreaterThanOrEqual("2024"):    "'{{Activity.CreatedAt}}' >= '2024'",
+		EMLActivityDate.LessThan("2024-02-01"):        "'{{Activity.CreatedAt}}' < '2024-02-01'",
+		EMLActivityDate.LessThanOrEqual("2024"):       "'{{Activity.CreatedAt}}' <= '2024'",
+		country.Like("U*"):                            "'{{Contact.Field(C_Country)}}' ~ 'U*'",
+		ContactListStatement(12).Exists():             "EXISTS('{{ContactList[12]}}')",
+		EMLAnd(country.Equal("UK")):                   "'{{Contact.Field(C_Country)}}' = 'UK'",
+		EMLAnd():                                      "",
+		EMLNot(ContactListStatement(12).Exists()):     "NOT (EXISTS('{{ContactList[12]}}'))",
+		EMLNot(EMLAnd()):                              "",
+		EMLOr(country.Equal("UK"), country.Equal("")): "('{{Contact.Field(C_Country)}}' = 'UK') OR ('{{Contact.Field(C_Country)}}' = '')",
+		EMLAnd(EMLActivityType.Equal("EmailOpen"), "", EMLActivityDate.GreaterThan("2024-01-01")): "('{{Activity.Type}}' = 'EmailOpen') AND ('{{Activity.CreatedAt}}' > '2024-01-01')",
+	}
+
+	for filter, want := range tests {
+		if filter.String() != want {
+			t.Errorf("EML filter not as expected, Expected %s, Recieved %s", want, filter)
+		}
+	}
+}
+
+func TestEMLFilterEscaping(t *testing.T) {
+	lastName := ContactFieldStatement("C_LastName")
+	tests := map[EMLFilter]string{
+		lastName.Equal("O'Brien"):  `'{{Contact.Field(C_LastName)}}' = 'O\'Brien'`,
+		lastName.Like(`O\B*`):      `'{{Contact.Field(C_LastName)}}' ~ 'O\\B*'`,
+		EMLActivityType.Equal("'"): `'{{Activity.Type}}' = '\''`,
+	}
+
+	for filter, want := range tests {
+		if filter.String() != want {
+			t.Errorf("Escaped EML filter not as expected, Expected %s, Recieved %s", want, filter)
+		}
+	}
+
+	want := `'{{Activity.Type}}' = 'O\'Brien'`
+	if filter := BulkActivityFilter("O'Brien", time.Time{}, time.Time{}); filter != want {
+		t.Errorf("BulkActivityFilter not as expected, Expected %s, Recieved %s", want, filter)
+	}
+}
@@ -0,0 +1,126 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ErrorResponse is the error returned when Eloqua responds with a non-2xx status code.
+// Use errors.As to access its details, or helpers such as IsNotFound to check for
+// common failures.
+type ErrorResponse struct {
+	// The HTTP response that caused the error
+	Response *http.Response
+	// The HTTP status code of the response
+	StatusCode int
+	// The method of the failed request
+	Method string
+	// The URL of the failed request
+	URL string
+	// The raw response body
+	Body string
+	// A descriptive message for the status code
+	Message string
+	// Validation errors returned by Eloqua, If the body contained any
+	ValidationErrors []ValidationError
+}
+
+// ValidationError is an Eloqua validation error, returned in the
+// body of a failed request to describe why an entity was rejected.
+type ValidationError struct {
+	Type        string                `json:"type,omitempty"`
+	Container   *TypeObject           `json:"container,omitempty"`
+	Property    string                `json:"property,omitempty"`
+	Requirement ValidationRequirement `json:"requirement,omitempty"`
+	// The rejected value, Usually a string
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ValidationRequirement describes the requirement a rejected value did not meet.
+type ValidationRequirement struct {
+	Type    string `json:"type,omitempty"`
+	Minimum int    `json:"minimum,omitempty"`
+	Maximum int    `json:"maximum,omitempty"`
+}
+
+// Error returns the descriptive message for the failed request.
+func (e *ErrorResponse) Error() string {
+	return e.Message
+}
+
+// newErrorResponse creates an ErrorResponse for the failed response with the given body.
+func newErrorResponse(r *http.Response, body string) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:   r,
+		StatusCode: r.StatusCode,
+		Body:       body,
+		Message:    "There was an issue performing your request",
+	}
+
+	if message, ok := errorMessages[r.StatusCode]; ok {
+		e.Message = message
+	}
+
+	if r.Request != nil {
+		e.Method = r.Request.Method
+		if r.Request.URL != nil {
+			e.URL = r.Request.URL.String()
+		}
+	}
+
+	// Validation errors are returned as an array of error objects
+	// but other errors may have plain text bodies, so failures are ignored.
+	var validationErrors []ValidationError
+	if json.Unmarshal([]byte(body), &validationErrors) == nil {
+		e.ValidationErrors = validationErrors
+	}
+
+	return e
+}
+
+// hasErrorStatus reports whether err is an ErrorResponse with one of the given status codes.
+func hasErrorStatus(err error, statusCodes ...int) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	for _, code := range statusCodes {
+		if errResp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err was caused by a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return hasErrorStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err was caused by a 409 Conflict response.
+func IsConflict(err error) bool {
+	return hasErrorStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err was caused by a 429 Too Many Requests response.
+func IsRateLimited(err error) bool {
+	return hasErrorStatus(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err was caused by a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return hasErrorStatus(err, http.StatusUnauthorized)
+}
+
+// IsValidationError reports whether err was caused by a 400 Bad Request response,
+// Returned by Eloqua when an entity fails validation.
+func IsValidationError(err error) bool {
+	return hasErrorStatus(err, http.StatusBadRequest)
+}
+
+// HasDependencies reports whether err was caused by a 412 response,
+// Returned by Eloqua when deleting an entity that other entities depend on.
+func HasDependencies(err error) bool {
+	return hasErrorStatus(err, http.StatusPreconditionFailed)
+}
@@ -0,0 +1,97 @@
+package eloqua
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `[{"type":"ObjectValidationError","container":{"type":"ObjectKey"},"property":"emailAddress","requirement":{"type":"UniquenessRequirement"},"value":"test@example.com"}]`
+
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(409)
+		fmt.Fprint(w, body)
+	})
+
+	_, resp, err := client.Contacts.Create("test@example.com", nil)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Expected an *ErrorResponse error, Recieved: %T %v", err, err)
+	}
+
+	if errResp.StatusCode != 409 || errResp.Method != "POST" || errResp.URL != server.URL+"/api/rest/2.0/data/contact" {
+		t.Errorf("Error response request details not as expected, Recieved: %d %s %s", errResp.StatusCode, errResp.Method, errResp.URL)
+	}
+	if errResp.Body != body || resp.ErrorContent != body {
+		t.Errorf("Error response body not as expected, Recieved: %s", errResp.Body)
+	}
+	if err.Error() != errorMessages[409] {
+		t.Errorf("Wrong error message received, \nExpected: %s\nRecieved: %s", errorMessages[409], err.Error())
+	}
+
+	want := []ValidationError{{
+		Type:        "ObjectValidationError",
+		Container:   &TypeObject{Type: "ObjectKey"},
+		Property:    "emailAddress",
+		Requirement: ValidationRequirement{Type: "UniquenessRequirement"},
+		Value:       "test@example.com",
+	}}
+	testModels(t, "ErrorResponse.ValidationErrors", errResp.ValidationErrors, want)
+
+	if !IsConflict(err) || IsNotFound(err) {
+		t.Error("Error should be reported as a conflict only")
+	}
+}
+
+func TestErrorResponsePlainBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprint(w, "Not found")
+	})
+
+	_, _, err := client.Contacts.Get(1)
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found error, Recieved: %v", err)
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.ValidationErrors != nil {
+		t.Error("Plain text bodies should not produce validation errors")
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		check      func(error) bool
+	}{
+		{401, IsUnauthorized},
+		{400, IsValidationError},
+		{404, IsNotFound},
+		{409, IsConflict},
+		{412, HasDependencies},
+		{429, IsRateLimited},
+	}
+
+	for _, test := range tests {
+		err := fmt.Errorf("wrapped: %w", &ErrorResponse{StatusCode: test.statusCode})
+		if !test.check(err) {
+			t.Errorf("Helper did not match wrapped %d error", test.statusCode)
+		}
+		if test.check(&ErrorResponse{StatusCode: 500}) {
+			t.Errorf("Helper for %d matched a 500 error", test.statusCode)
+		}
+		if test.check(errors.New("not an error response")) {
+			t.Errorf("Helper for %d matched a plain error", test.statusCode)
+		}
+	}
+}
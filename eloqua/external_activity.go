@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,28 +15,28 @@ type ExternalActivityService struct {
 
 // ExternalActivity represents an Eloqua External Activity object.
 type ExternalActivity struct {
-	Type         string `json:"type,omitempty"`
-	ID           int    `json:"id,omitempty,string"`
-	Depth        string `json:"depth,omitempty"`
-	Name         string `json:"name,omitempty"`
-	ActivityDate int    `json:"activityDate,omitempty,string"`
-	ActivityType string `json:"activityType,omitempty"`
-	AssetName    string `json:"assetName,omitempty"`
-	AssetType    string `json:"assetType,omitempty"`
-	ContactID    int    `json:"contactId,omitempty"`
-	CampaignID   int    `json:"campaignId,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	ID           int       `json:"id,omitempty,string"`
+	Depth        string    `json:"depth,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	ActivityDate Timestamp `json:"activityDate,omitempty"`
+	ActivityType string    `json:"activityType,omitempty"`
+	AssetName    string    `json:"assetName,omitempty"`
+	AssetType    string    `json:"assetType,omitempty"`
+	ContactID    int       `json:"contactId,omitempty"`
+	CampaignID   int       `json:"campaignId,omitempty"`
 }
 
 // ExternalActivityType represents a 'Type' of external activity.
 type ExternalActivityType struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	CreatedBy int    `json:"createdBy,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-	Name      string `json:"name,omitempty"`
-	UpdatedAt int    `json:"updatedAt,omitempty,string"`
-	UpdatedBy int    `json:"updatedBy,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	CreatedBy int       `json:"createdBy,omitempty,string"`
+	Depth     string    `json:"depth,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy int       `json:"updatedBy,omitempty,string"`
 }
 
 // Create a new External Activity in eloqua.
@@ -43,6 +44,12 @@ type ExternalActivityType struct {
 // Although ActivityDate is not required for creation, you should pass it through on the final parameter
 // as eloqua will not set this automatically as the current time.
 func (e *ExternalActivityService) Create(name string, assetName string, assetType string, activityType string,
+	campaignID int, contactID int, externalActivity *ExternalActivity) (*ExternalActivity, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, assetName, assetType, activityType, campaignID, contactID, externalActivity)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ExternalActivityService) CreateWithContext(ctx context.Context, name string, assetName string, assetType string, activityType string,
 	campaignID int, contactID int, externalActivity *ExternalActivity) (*ExternalActivity, *Response, error) {
 	if externalActivity == nil {
 		externalActivity = &ExternalActivity{}
@@ -55,14 +62,19 @@ func (e *ExternalActivityService) Create(name string, assetName string, assetTyp
 	externalActivity.ContactID = contactID
 
 	endpoint := "/data/activity"
-	resp, err := e.client.postRequestDecode(endpoint, externalActivity)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, externalActivity)
 	return externalActivity, resp, err
 }
 
 // Get an externalActivity object via its ID
 func (e *ExternalActivityService) Get(id int) (*ExternalActivity, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ExternalActivityService) GetWithContext(ctx context.Context, id int) (*ExternalActivity, *Response, error) {
 	endpoint := fmt.Sprintf("/data/activity/%d?depth=complete", id)
 	externalActivity := &ExternalActivity{}
-	resp, err := e.client.getRequestDecode(endpoint, externalActivity)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, externalActivity)
 	return externalActivity, resp, err
 }
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,11 +17,11 @@ type ExternalAssetTypeService struct {
 type ExternalAssetType struct {
 	Type          string                 `json:"type,omitempty"`
 	ID            int                    `json:"id,omitempty,string"`
-	CreatedAt     int                    `json:"createdAt,omitempty,string"`
+	CreatedAt     Timestamp              `json:"createdAt,omitempty"`
 	CreatedBy     int                    `json:"createdBy,omitempty,string"`
 	Depth         string                 `json:"depth,omitempty"`
 	Name          string                 `json:"name,omitempty"`
-	UpdatedAt     int                    `json:"updatedAt,omitempty,string"`
+	UpdatedAt     Timestamp              `json:"updatedAt,omitempty"`
 	UpdatedBy     int                    `json:"updatedBy,omitempty,string"`
 	ActivityTypes []ExternalActivityType `json:"activityTypes,omitempty,string"`
 }
@@ -28,35 +29,65 @@ type ExternalAssetType struct {
 // Create a new externalAssetType in eloqua.
 // New activity types can be created by sending them through this request.
 func (e *ExternalAssetTypeService) Create(name string, externalAssetType *ExternalAssetType) (*ExternalAssetType, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, externalAssetType)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ExternalAssetTypeService) CreateWithContext(ctx context.Context, name string, externalAssetType *ExternalAssetType) (*ExternalAssetType, *Response, error) {
 	if externalAssetType == nil {
 		externalAssetType = &ExternalAssetType{}
 	}
 	externalAssetType.Name = name
 
 	endpoint := "/assets/external/type"
-	resp, err := e.client.postRequestDecode(endpoint, externalAssetType)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, externalAssetType)
 	return externalAssetType, resp, err
 }
 
 // Get an externalAssetType object via its ID
 func (e *ExternalAssetTypeService) Get(id int) (*ExternalAssetType, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ExternalAssetTypeService) GetWithContext(ctx context.Context, id int) (*ExternalAssetType, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/external/type/%d?depth=complete", id)
 	externalAssetType := &ExternalAssetType{}
-	resp, err := e.client.getRequestDecode(endpoint, externalAssetType)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, externalAssetType)
 	return externalAssetType, resp, err
 }
 
 // List many eloqua externalAssetTypes
 func (e *ExternalAssetTypeService) List(opts *ListOptions) ([]ExternalAssetType, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ExternalAssetTypeService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ExternalAssetType, *Response, error) {
 	endpoint := "/assets/external/types"
 	externalAssetTypes := new([]ExternalAssetType)
-	resp, err := e.client.getRequestListDecode(endpoint, externalAssetTypes, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, externalAssetTypes, opts)
 	return *externalAssetTypes, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua externalAssetTypes
+func (e *ExternalAssetTypeService) ListPager(opts *ListOptions) *Pager[ExternalAssetType] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua externalAssetTypes, Requesting each page in turn
+func (e *ExternalAssetTypeService) ListAll(ctx context.Context, opts *ListOptions) ([]ExternalAssetType, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing externalAssetType in eloqua.
 // New activity types can be created by sending them through this request.
 func (e *ExternalAssetTypeService) Update(id int, name string, externalAssetType *ExternalAssetType) (*ExternalAssetType, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, externalAssetType)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ExternalAssetTypeService) UpdateWithContext(ctx context.Context, id int, name string, externalAssetType *ExternalAssetType) (*ExternalAssetType, *Response, error) {
 	if externalAssetType == nil {
 		externalAssetType = &ExternalAssetType{}
 	}
@@ -65,14 +96,19 @@ func (e *ExternalAssetTypeService) Update(id int, name string, externalAssetType
 	externalAssetType.Name = name
 
 	endpoint := fmt.Sprintf("/assets/external/type/%d", externalAssetType.ID)
-	resp, err := e.client.putRequestDecode(endpoint, externalAssetType)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, externalAssetType)
 	return externalAssetType, resp, err
 }
 
 // Delete an existing externalAssetType from eloqua
 func (e *ExternalAssetTypeService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ExternalAssetTypeService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	externalAssetType := &ExternalAssetType{ID: id}
 	endpoint := fmt.Sprintf("/assets/external/type/%d", externalAssetType.ID)
-	resp, err := e.client.deleteRequest(endpoint, externalAssetType)
+	resp, err := e.client.deleteRequest(ctx, endpoint, externalAssetType)
 	return resp, err
 }
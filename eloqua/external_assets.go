@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,47 +15,77 @@ type ExternalAssetService struct {
 
 // ExternalAsset represents an Eloqua ExternalAsset object.
 type ExternalAsset struct {
-	Type                string `json:"type,omitempty"`
-	ID                  int    `json:"id,omitempty,string"`
-	CreatedAt           int    `json:"createdAt,omitempty,string"`
-	CreatedBy           int    `json:"createdBy,omitempty,string"`
-	Depth               string `json:"depth,omitempty"`
-	Name                string `json:"name,omitempty"`
-	UpdatedAt           int    `json:"updatedAt,omitempty,string"`
-	UpdatedBy           int    `json:"updatedBy,omitempty,string"`
-	ExternalAssetTypeID int    `json:"externalAssetTypeId,omitempty,string"`
+	Type                string    `json:"type,omitempty"`
+	ID                  int       `json:"id,omitempty,string"`
+	CreatedAt           Timestamp `json:"createdAt,omitempty"`
+	CreatedBy           int       `json:"createdBy,omitempty,string"`
+	Depth               string    `json:"depth,omitempty"`
+	Name                string    `json:"name,omitempty"`
+	UpdatedAt           Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy           int       `json:"updatedBy,omitempty,string"`
+	ExternalAssetTypeID int       `json:"externalAssetTypeId,omitempty,string"`
 }
 
 // Create a new externalAsset in eloqua
 func (e *ExternalAssetService) Create(name string, externalAsset *ExternalAsset) (*ExternalAsset, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, externalAsset)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ExternalAssetService) CreateWithContext(ctx context.Context, name string, externalAsset *ExternalAsset) (*ExternalAsset, *Response, error) {
 	if externalAsset == nil {
 		externalAsset = &ExternalAsset{}
 	}
 	externalAsset.Name = name
 
 	endpoint := "/assets/external"
-	resp, err := e.client.postRequestDecode(endpoint, externalAsset)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, externalAsset)
 	return externalAsset, resp, err
 }
 
 // Get an externalAsset object via its ID
 func (e *ExternalAssetService) Get(id int) (*ExternalAsset, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ExternalAssetService) GetWithContext(ctx context.Context, id int) (*ExternalAsset, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/external/%d?depth=complete", id)
 	externalAsset := &ExternalAsset{}
-	resp, err := e.client.getRequestDecode(endpoint, externalAsset)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, externalAsset)
 	return externalAsset, resp, err
 }
 
 // List many eloqua externalAssets
 func (e *ExternalAssetService) List(opts *ListOptions) ([]ExternalAsset, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ExternalAssetService) ListWithContext(ctx context.Context, opts *ListOptions) ([]ExternalAsset, *Response, error) {
 	endpoint := "/assets/externals"
 	externalAssets := new([]ExternalAsset)
-	resp, err := e.client.getRequestListDecode(endpoint, externalAssets, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, externalAssets, opts)
 	return *externalAssets, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua externalAssets
+func (e *ExternalAssetService) ListPager(opts *ListOptions) *Pager[ExternalAsset] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua externalAssets, Requesting each page in turn
+func (e *ExternalAssetService) ListAll(ctx context.Context, opts *ListOptions) ([]ExternalAsset, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing externalAsset in eloqua
 func (e *ExternalAssetService) Update(id int, name string, externalAsset *ExternalAsset) (*ExternalAsset, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, externalAsset)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ExternalAssetService) UpdateWithContext(ctx context.Context, id int, name string, externalAsset *ExternalAsset) (*ExternalAsset, *Response, error) {
 	if externalAsset == nil {
 		externalAsset = &ExternalAsset{}
 	}
@@ -63,7 +94,7 @@ func (e *ExternalAssetService) Update(id int, name string, externalAsset *Extern
 	externalAsset.Name = name
 
 	endpoint := fmt.Sprintf("/assets/external/%d", externalAsset.ID)
-	resp, err := e.client.putRequestDecode(endpoint, externalAsset)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, externalAsset)
 	return externalAsset, resp, err
 }
 
@@ -71,8 +102,13 @@ func (e *ExternalAssetService) Update(id int, name string, externalAsset *Extern
 // During testing this did not seem to function but it is
 // in the documentation and does not return an error so it will remain for now.
 func (e *ExternalAssetService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ExternalAssetService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	externalAsset := &ExternalAsset{ID: id}
 	endpoint := fmt.Sprintf("/assets/external/%d", externalAsset.ID)
-	resp, err := e.client.deleteRequest(endpoint, externalAsset)
+	resp, err := e.client.deleteRequest(ctx, endpoint, externalAsset)
 	return resp, err
 }
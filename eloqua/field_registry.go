@@ -0,0 +1,448 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FieldRegistry caches the field definitions of contacts, accounts, custom objects & forms
+// so that field values can be read and written by internal or display name rather than ID.
+// The registry is available on the client as client.Fields.
+//
+//	fields, err := client.Fields.Contact(ctx)
+//	score, err := contact.Field(fields, "C_Lead_Score").Int()
+type FieldRegistry struct {
+	client *Client
+
+	mu            sync.Mutex
+	contact       *FieldSet
+	account       *FieldSet
+	customObjects map[int]*FieldSet
+	forms         map[int]*FieldSet
+}
+
+// FieldDefinition describes a field that values can be read or written for.
+type FieldDefinition struct {
+	ID           int
+	Name         string
+	InternalName string
+	DataType     string
+}
+
+// FieldSet is a set of field definitions, Such as every contact field,
+// That can be looked up by internal or display name.
+type FieldSet struct {
+	Fields []FieldDefinition
+
+	byInternalName map[string]FieldDefinition
+	byName         map[string]FieldDefinition
+}
+
+// NewFieldSet creates a FieldSet from the given definitions.
+func NewFieldSet(fields []FieldDefinition) *FieldSet {
+	s := &FieldSet{
+		Fields:         fields,
+		byInternalName: make(map[string]FieldDefinition, len(fields)),
+		byName:         make(map[string]FieldDefinition, len(fields)),
+	}
+	for _, field := range fields {
+		if field.InternalName != "" {
+			s.byInternalName[strings.ToLower(field.InternalName)] = field
+		}
+		if field.Name != "" {
+			s.byName[strings.ToLower(field.Name)] = field
+		}
+	}
+	return s
+}
+
+// Lookup finds a field by its internal name, Or if none match by its display name.
+// Names are matched case-insensitively.
+func (s *FieldSet) Lookup(name string) (FieldDefinition, bool) {
+	key := strings.ToLower(name)
+	if field, ok := s.byInternalName[key]; ok {
+		return field, true
+	}
+	field, ok := s.byName[key]
+	return field, ok
+}
+
+// LookupID finds a field by its ID.
+func (s *FieldSet) LookupID(id int) (FieldDefinition, bool) {
+	for _, field := range s.Fields {
+		if field.ID == id {
+			return field, true
+		}
+	}
+	return FieldDefinition{}, false
+}
+
+// Get returns the value of the named field from values.
+// The returned FieldData is empty, With an error, if the field is unknown.
+// Standard contact fields, Such as C_EmailAddress, are held in Contact properties
+// rather than field values so give an error unless present in values, Use Contact.Field to read them.
+func (s *FieldSet) Get(values []FieldValue, name string) FieldData {
+	data := s.get(values, name)
+	if data.err == nil && !data.IsSet {
+		if _, ok := standardContactFields[strings.ToLower(data.Field.InternalName)]; ok {
+			data.err = fmt.Errorf("Field %s is a standard contact field, Read it with Contact.Field", data.Field.InternalName)
+		}
+	}
+	return data
+}
+
+// get returns the value of the named field from values.
+func (s *FieldSet) get(values []FieldValue, name string) FieldData {
+	field, ok := s.Lookup(name)
+	if !ok {
+		return FieldData{err: fmt.Errorf("Unknown field %q", name)}
+	}
+
+	data := FieldData{Field: field}
+	for _, fv := range values {
+		if fv.ID == field.ID {
+			data.Value = fv.Value
+			data.IsSet = true
+		}
+	}
+	return data
+}
+
+// Set sets the value of the named field in values, Adding it if not already present.
+// The value is formatted according to the field's data type, Times & Timestamps are
+// converted to epoch strings for date fields. Values that are not valid for the
+// data type, Such as "abc" for a number field, give an error.
+func (s *FieldSet) Set(values *[]FieldValue, name string, value interface{}) error {
+	field, ok := s.Lookup(name)
+	if !ok {
+		return fmt.Errorf("Unknown field %q", name)
+	}
+
+	formatted, err := formatFieldValue(field, value)
+	if err != nil {
+		return err
+	}
+
+	for i := range *values {
+		if (*values)[i].ID == field.ID {
+			(*values)[i].Value = formatted
+			return nil
+		}
+	}
+	*values = append(*values, FieldValue{Type: "FieldValue", ID: field.ID, Value: formatted})
+	return nil
+}
+
+// formatFieldValue formats a value as the string Eloqua expects for the field,
+// Checking that it is valid for the field's data type.
+func formatFieldValue(field FieldDefinition, value interface{}) (string, error) {
+	formatted, err := formatValue(field, value)
+	if err != nil || formatted == "" {
+		return formatted, err
+	}
+
+	switch field.DataType {
+	case "number", "numeric":
+		if _, err := strconv.ParseFloat(formatted, 64); err != nil {
+			return "", fmt.Errorf("Cannot set number field %s to %q", field.InternalName, formatted)
+		}
+	case "date":
+		if _, err := strconv.ParseInt(formatted, 10, 64); err != nil {
+			return "", fmt.Errorf("Cannot set date field %s to %q, Dates must be epoch seconds", field.InternalName, formatted)
+		}
+	}
+	return formatted, nil
+}
+
+// formatValue converts a value to a string for the field.
+func formatValue(field FieldDefinition, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case Timestamp:
+		return strconv.FormatInt(int64(v), 10), nil
+	case time.Time:
+		if field.DataType != "date" {
+			return "", fmt.Errorf("Cannot set %s field %s to a time", field.DataType, field.InternalName)
+		}
+		return strconv.FormatInt(int64(NewTimestamp(v)), 10), nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("Unsupported value type %T for field %s", value, field.InternalName)
+}
+
+// FieldData is the value of a field, Read via a FieldSet, with conversions
+// based on the field's data type.
+type FieldData struct {
+	Field FieldDefinition
+	Value string
+	// Whether the field had a value, Even if empty
+	IsSet bool
+
+	err error
+}
+
+// Err returns an error if the field could not be found.
+func (d FieldData) Err() error {
+	return d.err
+}
+
+// String returns the raw field value.
+func (d FieldData) String() string {
+	return d.Value
+}
+
+// Int returns the value of a numeric field as an int.
+// Empty values give zero.
+func (d FieldData) Int() (int, error) {
+	if d.err != nil || d.Value == "" {
+		return 0, d.err
+	}
+	if n, err := strconv.Atoi(d.Value); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(d.Value, 64)
+	return int(f), err
+}
+
+// Float returns the value of a numeric field as a float64.
+// Empty values give zero.
+func (d FieldData) Float() (float64, error) {
+	if d.err != nil || d.Value == "" {
+		return 0, d.err
+	}
+	return strconv.ParseFloat(d.Value, 64)
+}
+
+// Bool returns the value of a field as a bool.
+// "true", "yes", "on" & "1" are true, Empty values are false.
+func (d FieldData) Bool() (bool, error) {
+	if d.err != nil || d.Value == "" {
+		return false, d.err
+	}
+	switch strings.ToLower(d.Value) {
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("Field %s value %q is not a boolean", d.Field.InternalName, d.Value)
+}
+
+// Time returns the value of a date field as a UTC time.
+// Empty values give the zero time.
+func (d FieldData) Time() (time.Time, error) {
+	if d.err != nil || d.Value == "" {
+		return time.Time{}, d.err
+	}
+	var ts Timestamp
+	if err := ts.UnmarshalJSON([]byte(d.Value)); err != nil {
+		return time.Time{}, fmt.Errorf("Field %s value %q is not a date", d.Field.InternalName, d.Value)
+	}
+	return ts.Time(), nil
+}
+
+// Interface returns the value converted according to the field's data type,
+// time.Time for dates, float64 for numbers & string otherwise.
+// Empty values give nil.
+func (d FieldData) Interface() (interface{}, error) {
+	if d.err != nil || d.Value == "" {
+		return nil, d.err
+	}
+	switch d.Field.DataType {
+	case "date":
+		return d.Time()
+	case "number", "numeric":
+		return d.Float()
+	}
+	return d.Value, nil
+}
+
+// standardContactFields maps the internal names of standard contact fields to the Contact property holding their value
+var standardContactFields = map[string]func(c *Contact) string{
+	"c_emailaddress": func(c *Contact) string { return c.EmailAddress },
+	"c_firstname":    func(c *Contact) string { return c.FirstName },
+	"c_lastname":     func(c *Contact) string { return c.LastName },
+	"c_company":      func(c *Contact) string { return c.AccountName },
+	"c_busphone":     func(c *Contact) string { return c.BusinessPhone },
+	"c_country":      func(c *Contact) string { return c.Country },
+	"c_zip_postal":   func(c *Contact) string { return c.PostalCode },
+	"c_state_prov":   func(c *Contact) string { return c.Province },
+	"c_title":        func(c *Contact) string { return c.Title },
+	"c_salesperson":  func(c *Contact) string { return c.SalesPerson },
+}
+
+// Field returns the value of the named field of the contact.
+// Standard contact fields, Such as C_EmailAddress, are read from the matching
+// Contact property when not present in the field values.
+func (c *Contact) Field(fields *FieldSet, name string) FieldData {
+	data := fields.get(c.FieldValues, name)
+	if data.err == nil && !data.IsSet {
+		if get, ok := standardContactFields[strings.ToLower(data.Field.InternalName)]; ok {
+			data.Value = get(c)
+			data.IsSet = data.Value != ""
+		}
+	}
+	return data
+}
+
+// SetField sets the value of the named field of the contact.
+func (c *Contact) SetField(fields *FieldSet, name string, value interface{}) error {
+	return fields.Set(&c.FieldValues, name, value)
+}
+
+// Field returns the value of the named field of the account.
+func (a *Account) Field(fields *FieldSet, name string) FieldData {
+	return fields.Get(a.FieldValues, name)
+}
+
+// SetField sets the value of the named field of the account.
+func (a *Account) SetField(fields *FieldSet, name string, value interface{}) error {
+	return fields.Set(&a.FieldValues, name, value)
+}
+
+// Field returns the value of the named field of the form submission.
+func (d *FormData) Field(fields *FieldSet, name string) FieldData {
+	return fields.Get(d.FieldValues, name)
+}
+
+// SetField sets the value of the named field of the form submission.
+func (d *FormData) SetField(fields *FieldSet, name string, value interface{}) error {
+	return fields.Set(&d.FieldValues, name, value)
+}
+
+// Field returns the value of the named field of the custom object record.
+func (d *CustomObjectData) Field(fields *FieldSet, name string) FieldData {
+	return fields.Get(d.FieldValues, name)
+}
+
+// SetField sets the value of the named field of the custom object record.
+func (d *CustomObjectData) SetField(fields *FieldSet, name string, value interface{}) error {
+	return fields.Set(&d.FieldValues, name, value)
+}
+
+// Contact returns the contact fields, Requesting them on first use.
+func (r *FieldRegistry) Contact(ctx context.Context) (*FieldSet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.contact != nil {
+		return r.contact, nil
+	}
+
+	contactFields, err := r.client.ContactFields.ListAll(ctx, &ListOptions{Depth: "complete"})
+	if err != nil {
+		return nil, err
+	}
+	r.contact = NewFieldSet(contactFieldDefinitions(contactFields))
+	return r.contact, nil
+}
+
+// Account returns the account fields, Requesting them on first use.
+func (r *FieldRegistry) Account(ctx context.Context) (*FieldSet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.account != nil {
+		return r.account, nil
+	}
+
+	pager := newPager(&ListOptions{Depth: "complete"}, func(ctx context.Context, opts *ListOptions) ([]ContactField, *Response, error) {
+		accountFields := new([]ContactField)
+		resp, err := r.client.getRequestListDecode(ctx, "/assets/account/fields", accountFields, opts)
+		return *accountFields, resp, err
+	})
+	accountFields, err := pager.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.account = NewFieldSet(contactFieldDefinitions(accountFields))
+	return r.account, nil
+}
+
+// CustomObject returns the fields of the custom object with the given ID, Requesting them on first use.
+func (r *FieldRegistry) CustomObject(ctx context.Context, cdoID int) (*FieldSet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if set, ok := r.customObjects[cdoID]; ok {
+		return set, nil
+	}
+
+	customObject, _, err := r.client.CustomObjects.GetWithContext(ctx, cdoID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]FieldDefinition, len(customObject.Fields))
+	for i, f := range customObject.Fields {
+		fields[i] = FieldDefinition{ID: f.ID, Name: f.Name, InternalName: f.InternalName, DataType: f.DataType}
+	}
+
+	if r.customObjects == nil {
+		r.customObjects = map[int]*FieldSet{}
+	}
+	r.customObjects[cdoID] = NewFieldSet(fields)
+	return r.customObjects[cdoID], nil
+}
+
+// Form returns the fields of the form with the given ID, Requesting them on first use.
+// The internal name of a form field is its HTML name.
+func (r *FieldRegistry) Form(ctx context.Context, formID int) (*FieldSet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if set, ok := r.forms[formID]; ok {
+		return set, nil
+	}
+
+	form, _, err := r.client.Forms.GetWithContext(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]FieldDefinition, len(form.FormFields))
+	for i, f := range form.FormFields {
+		fields[i] = FieldDefinition{ID: f.ID, Name: f.Name, InternalName: f.HTMLName, DataType: f.DataType}
+	}
+
+	if r.forms == nil {
+		r.forms = map[int]*FieldSet{}
+	}
+	r.forms[formID] = NewFieldSet(fields)
+	return r.forms[formID], nil
+}
+
+// Invalidate clears the cached fields so that they are requested again on next use,
+// For example after fields have been created or renamed.
+func (r *FieldRegistry) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.contact = nil
+	r.account = nil
+	r.customObjects = nil
+	r.forms = nil
+}
+
+// contactFieldDefinitions converts contact fields to field definitions.
+func contactFieldDefinitions(contactFields []ContactField) []FieldDefinition {
+	fields := make([]FieldDefinition, len(contactFields))
+	for i, f := range contactFields {
+		fields[i] = FieldDefinition{ID: f.ID, Name: f.Name, InternalName: f.InternalName, DataType: f.DataType}
+	}
+	return fields
+}
@@ -0,0 +1,240 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// addContactFieldsHandler serves a set of contact fields, Returning a pointer to the request count.
+func addContactFieldsHandler(t *testing.T) *int {
+	requests := 0
+	addRestHandlerFunc("/assets/contact/fields", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		testURLParam(t, req, "depth", "complete")
+		fmt.Fprint(w, `{"elements":[
+			{"type":"ContactField","id":"100001","name":"Email Address","internalName":"C_EmailAddress","dataType":"text"},
+			{"type":"ContactField","id":"100200","name":"Lead Score","internalName":"C_Lead_Score","dataType":"number"},
+			{"type":"ContactField","id":"100201","name":"Last Purchase","internalName":"C_Last_Purchase1","dataType":"date"},
+			{"type":"ContactField","id":"100202","name":"VIP","internalName":"C_VIP1","dataType":"text"}
+		],"page":1,"pageSize":1000,"total":4}`)
+	})
+	return &requests
+}
+
+func TestFieldRegistryContact(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := addContactFieldsHandler(t)
+
+	fields, err := client.Fields.Contact(context.Background())
+	if err != nil {
+		t.Fatalf("Fields.Contact recieved error: %v", err)
+	}
+	if _, err := client.Fields.Contact(context.Background()); err != nil || *requests != 1 {
+		t.Errorf("Expected contact fields to be cached, Recieved %d requests, %v", *requests, err)
+	}
+
+	client.Fields.Invalidate()
+	client.Fields.Contact(context.Background())
+	if *requests != 2 {
+		t.Errorf("Expected contact fields to be requested again after Invalidate, Recieved %d requests", *requests)
+	}
+
+	field, ok := fields.Lookup("c_lead_score")
+	if !ok || field.ID != 100200 {
+		t.Errorf("Lookup by internal name not as expected, Recieved: %+v", field)
+	}
+	field, ok = fields.Lookup("Last Purchase")
+	if !ok || field.InternalName != "C_Last_Purchase1" {
+		t.Errorf("Lookup by display name not as expected, Recieved: %+v", field)
+	}
+	if _, ok := fields.Lookup("C_Missing"); ok {
+		t.Error("Lookup should not find a missing field")
+	}
+}
+
+func TestContactFieldValues(t *testing.T) {
+	setup()
+	defer teardown()
+	addContactFieldsHandler(t)
+
+	fields, _ := client.Fields.Contact(context.Background())
+	contact := &Contact{FieldValues: []FieldValue{
+		{Type: "FieldValue", ID: 100200, Value: "42"},
+		{Type: "FieldValue", ID: 100201, Value: "1420070400"},
+		{Type: "FieldValue", ID: 100202, Value: "Yes"},
+	}}
+
+	if score, err := contact.Field(fields, "C_Lead_Score").Int(); err != nil || score != 42 {
+		t.Errorf("Lead score not as expected, Recieved: %d %v", score, err)
+	}
+	if score, err := contact.Field(fields, "Lead Score").Float(); err != nil || score != 42 {
+		t.Errorf("Lead score not as expected, Recieved: %f %v", score, err)
+	}
+	if vip, err := contact.Field(fields, "C_VIP1").Bool(); err != nil || !vip {
+		t.Errorf("VIP not as expected, Recieved: %t %v", vip, err)
+	}
+
+	purchased := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	if value, err := contact.Field(fields, "C_Last_Purchase1").Interface(); err != nil || value != purchased {
+		t.Errorf("Last purchase not as expected, Recieved: %v %v", value, err)
+	}
+
+	email := contact.Field(fields, "C_EmailAddress")
+	if email.IsSet || email.String() != "" {
+		t.Errorf("Expected unset field to be empty, Recieved: %+v", email)
+	}
+	if value, err := email.Interface(); value != nil || err != nil {
+		t.Errorf("Expected nil for empty value, Recieved: %v %v", value, err)
+	}
+
+	missing := contact.Field(fields, "C_Missing")
+	if missing.Err() == nil {
+		t.Error("Expected an error getting an unknown field")
+	}
+	if _, err := missing.Int(); err == nil {
+		t.Error("Expected an error converting an unknown field")
+	}
+
+	if err := contact.SetField(fields, "Lead Score", 50); err != nil {
+		t.Errorf("SetField recieved error: %v", err)
+	}
+	if err := contact.SetField(fields, "C_Last_Purchase1", purchased.AddDate(0, 0, 1)); err != nil {
+		t.Errorf("SetField recieved error: %v", err)
+	}
+	if err := contact.SetField(fields, "C_EmailAddress", "test@example.com"); err != nil {
+		t.Errorf("SetField recieved error: %v", err)
+	}
+
+	want := []FieldValue{
+		{Type: "FieldValue", ID: 100200, Value: "50"},
+		{Type: "FieldValue", ID: 100201, Value: "1420156800"},
+		{Type: "FieldValue", ID: 100202, Value: "Yes"},
+		{Type: "FieldValue", ID: 100001, Value: "test@example.com"},
+	}
+	testModels(t, "Contact.SetField", contact.FieldValues, want)
+
+	if err := contact.SetField(fields, "C_Missing", "x"); err == nil {
+		t.Error("Expected an error setting an unknown field")
+	}
+	if err := contact.SetField(fields, "C_VIP1", time.Now()); err == nil {
+		t.Error("Expected an error setting a time on a text field")
+	}
+	if err := contact.SetField(fields, "C_VIP1", []string{}); err == nil {
+		t.Error("Expected an error setting an unsupported value type")
+	}
+}
+
+func TestContactStandardFieldValues(t *testing.T) {
+	setup()
+	defer teardown()
+	addContactFieldsHandler(t)
+
+	fields, _ := client.Fields.Contact(context.Background())
+	contact := &Contact{EmailAddress: "jane@example.com"}
+
+	email := contact.Field(fields, "Email Address")
+	if email.Err() != nil || !email.IsSet || email.String() != "jane@example.com" {
+		t.Errorf("Expected email address from the contact property, Recieved: %+v", email)
+	}
+
+	if data := fields.Get(contact.FieldValues, "C_EmailAddress"); data.Err() == nil {
+		t.Error("Expected an error getting a standard contact field from field values")
+	}
+
+	contact.FieldValues = []FieldValue{{Type: "FieldValue", ID: 100001, Value: "test@example.com"}}
+	if email := contact.Field(fields, "C_EmailAddress"); email.String() != "test@example.com" {
+		t.Errorf("Expected email address from field values, Recieved: %+v", email)
+	}
+}
+
+func TestSetFieldDataTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	addContactFieldsHandler(t)
+
+	fields, _ := client.Fields.Contact(context.Background())
+	contact := &Contact{}
+
+	valid := map[string]interface{}{
+		"C_Lead_Score":     "42.5",
+		"C_Last_Purchase1": "1420070400",
+		"C_VIP1":           true,
+	}
+	for name, value := range valid {
+		if err := contact.SetField(fields, name, value); err != nil {
+			t.Errorf("SetField %s recieved error: %v", name, err)
+		}
+	}
+	if err := contact.SetField(fields, "C_Lead_Score", ""); err != nil {
+		t.Errorf("Expected an empty value to clear a number field, Recieved error: %v", err)
+	}
+
+	invalid := []struct {
+		name  string
+		value interface{}
+	}{
+		{"C_Lead_Score", "abc"},
+		{"C_Lead_Score", true},
+		{"C_Last_Purchase1", "yesterday"},
+		{"C_Last_Purchase1", 1.5},
+	}
+	for _, test := range invalid {
+		if err := contact.SetField(fields, test.name, test.value); err == nil {
+			t.Errorf("Expected an error setting %s to %v", test.name, test.value)
+		}
+	}
+}
+
+func TestFieldRegistryOtherEntities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/account/fields", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"elements":[{"type":"AccountField","id":"100100","name":"Company","internalName":"M_CompanyName","dataType":"text"}],"page":1,"pageSize":1000,"total":1}`)
+	})
+	addRestHandlerFunc("/assets/customObject/4", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"CustomObject","id":"4","fields":[{"type":"CustomObjectField","id":"32","name":"Order Total","internalName":"Order_Total1","dataType":"number"}]}`)
+	})
+	addRestHandlerFunc("/assets/form/7", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Form","id":"7","elements":[{"type":"FormField","id":"55","name":"First Name","htmlName":"firstName","dataType":"text"}]}`)
+	})
+
+	ctx := context.Background()
+
+	accountFields, err := client.Fields.Account(ctx)
+	if err != nil {
+		t.Fatalf("Fields.Account recieved error: %v", err)
+	}
+	account := &Account{}
+	account.SetField(accountFields, "M_CompanyName", "Acme")
+	if account.Field(accountFields, "Company").String() != "Acme" {
+		t.Errorf("Account field not as expected, Recieved: %+v", account.FieldValues)
+	}
+
+	cdoFields, err := client.Fields.CustomObject(ctx, 4)
+	if err != nil {
+		t.Fatalf("Fields.CustomObject recieved error: %v", err)
+	}
+	record := &CustomObjectData{FieldValues: []FieldValue{{ID: 32, Value: "19.99"}}}
+	if total, err := record.Field(cdoFields, "Order Total").Float(); err != nil || total != 19.99 {
+		t.Errorf("Custom object field not as expected, Recieved: %f %v", total, err)
+	}
+	record.SetField(cdoFields, "Order_Total1", 25.5)
+	if record.FieldValues[0].Value != "25.5" {
+		t.Errorf("Custom object field not set as expected, Recieved: %+v", record.FieldValues)
+	}
+
+	formFields, err := client.Fields.Form(ctx, 7)
+	if err != nil {
+		t.Fatalf("Fields.Form recieved error: %v", err)
+	}
+	submission := &FormData{}
+	submission.SetField(formFields, "firstName", "Jane")
+	if submission.Field(formFields, "First Name").String() != "Jane" {
+		t.Errorf("Form field not as expected, Recieved: %+v", submission.FieldValues)
+	}
+}
@@ -0,0 +1,258 @@
+package eloqua
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter criteria tree operators
+const (
+	FilterAnd = "AND"
+	FilterOr  = "OR"
+)
+
+// FilterNode is a node within a contact filter's criteria tree.
+// A node is Either a single criterion or a group of nodes joined by an operator.
+type FilterNode struct {
+	Operator  string
+	Nodes     []FilterNode
+	Criterion *FilterCriterion
+}
+
+// FilterMatch creates a leaf node matching the given criterion.
+func FilterMatch(criterion FilterCriterion) FilterNode {
+	return FilterNode{Criterion: &criterion}
+}
+
+// FilterAll creates a node that matches when all of the given nodes match.
+func FilterAll(nodes ...FilterNode) FilterNode {
+	return FilterNode{Operator: FilterAnd, Nodes: nodes}
+}
+
+// FilterAny creates a node that matches when any of the given nodes match.
+func FilterAny(nodes ...FilterNode) FilterNode {
+	return FilterNode{Operator: FilterOr, Nodes: nodes}
+}
+
+// NewContactFieldCriterion creates a criterion comparing the contact field with the given ID.
+// Unlike ContactFieldCriterion, Which is limited to text values, Any condition may be used.
+func NewContactFieldCriterion(fieldID int, condition FilterCondition) FilterCriterion {
+	return FilterCriterion{
+		Type:      "ContactFieldComparisonCriterion",
+		FieldID:   fieldID,
+		Condition: &condition,
+	}
+}
+
+// TextCondition creates a condition comparing a text value using the given operator, Such as "equal".
+func TextCondition(operator, value string) FilterCondition {
+	return FilterCondition{Type: "TextValueCondition", Operator: operator, Value: value}
+}
+
+// NumericCondition creates a condition comparing a numeric value using the given operator.
+func NumericCondition(operator string, value float64) FilterCondition {
+	return FilterCondition{Type: "NumericValueCondition", Operator: operator, Value: strconv.FormatFloat(value, 'f', -1, 64)}
+}
+
+// DateCondition creates a condition comparing a date value using the given operator.
+func DateCondition(operator string, value Timestamp) FilterCondition {
+	return FilterCondition{Type: "DateValueCondition", Operator: operator, Value: strconv.FormatInt(int64(value), 10)}
+}
+
+// SetFilterTree replaces the filter's criteria & statement with those described by the given tree.
+// Criteria without an ID are given new negative IDs, As Eloqua expects for criteria that
+// do not yet exist.
+func (f *ContactFilter) SetFilterTree(tree FilterNode) error {
+	nextID := -1
+	var walk func(n FilterNode)
+	walk = func(n FilterNode) {
+		if n.Criterion != nil && n.Criterion.ID <= nextID {
+			nextID = n.Criterion.ID - 1
+		}
+		for _, child := range n.Nodes {
+			walk(child)
+		}
+	}
+	walk(tree)
+
+	var criteria []FilterCriterion
+	var build func(n FilterNode, nested bool) (string, error)
+	build = func(n FilterNode, nested bool) (string, error) {
+		if n.Criterion != nil {
+			if len(n.Nodes) > 0 {
+				return "", fmt.Errorf("Filter node has both a criterion and child nodes")
+			}
+			c := *n.Criterion
+			if c.ID == 0 {
+				c.ID = nextID
+				nextID--
+			}
+			criteria = append(criteria, c)
+			return strconv.Itoa(c.ID), nil
+		}
+
+		if n.Operator != FilterAnd && n.Operator != FilterOr {
+			return "", fmt.Errorf("Invalid filter operator %q", n.Operator)
+		}
+		if len(n.Nodes) == 0 {
+			return "", fmt.Errorf("Filter group has no nodes")
+		}
+
+		parts := make([]string, 0, len(n.Nodes))
+		for _, child := range n.Nodes {
+			part, err := build(child, true)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		if len(parts) == 1 {
+			return parts[0], nil
+		}
+
+		statement := strings.Join(parts, " "+n.Operator+" ")
+		if nested {
+			statement = "( " + statement + " )"
+		}
+		return statement, nil
+	}
+
+	statement, err := build(tree, false)
+	if err != nil {
+		return err
+	}
+
+	f.Statement = statement
+	f.Criteria = criteria
+	return nil
+}
+
+// FilterTree parses the filter's statement into a tree of its criteria.
+// Where AND & OR are mixed without brackets, AND takes precedence.
+func (f *ContactFilter) FilterTree() (FilterNode, error) {
+	byID := make(map[int]FilterCriterion, len(f.Criteria))
+	for _, c := range f.Criteria {
+		byID[c.ID] = c
+	}
+
+	p := &filterParser{tokens: tokenizeFilterStatement(f.Statement), criteria: byID}
+	if len(p.tokens) == 0 {
+		return FilterNode{}, fmt.Errorf("Filter statement is empty")
+	}
+
+	node, err := p.parseGroup(FilterOr)
+	if err != nil {
+		return FilterNode{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return FilterNode{}, fmt.Errorf("Unexpected %q in filter statement", p.tokens[p.pos])
+	}
+	return node, nil
+}
+
+// tokenizeFilterStatement splits a filter statement into IDs, Operators & brackets
+func tokenizeFilterStatement(statement string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range statement {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// filterParser is a recursive descent parser for filter statements
+type filterParser struct {
+	tokens   []string
+	pos      int
+	criteria map[int]FilterCriterion
+}
+
+// parseGroup parses nodes joined by the given operator,
+// Parsing the operands of an OR as AND groups.
+func (p *filterParser) parseGroup(operator string) (FilterNode, error) {
+	operand := p.parsePrimary
+	if operator == FilterOr {
+		operand = func() (FilterNode, error) { return p.parseGroup(FilterAnd) }
+	}
+
+	first, err := operand()
+	if err != nil {
+		return FilterNode{}, err
+	}
+
+	var nodes []FilterNode
+	add := func(n FilterNode) {
+		if n.Criterion == nil && n.Operator == operator {
+			nodes = append(nodes, n.Nodes...)
+		} else {
+			nodes = append(nodes, n)
+		}
+	}
+
+	add(first)
+	joined := false
+	for p.pos < len(p.tokens) && strings.EqualFold(p.tokens[p.pos], operator) {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return FilterNode{}, err
+		}
+		add(next)
+		joined = true
+	}
+
+	if !joined {
+		return first, nil
+	}
+	return FilterNode{Operator: operator, Nodes: nodes}, nil
+}
+
+// parsePrimary parses a single criterion ID or a bracketed group
+func (p *filterParser) parsePrimary() (FilterNode, error) {
+	if p.pos >= len(p.tokens) {
+		return FilterNode{}, fmt.Errorf("Unexpected end of filter statement")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	if token == "(" {
+		node, err := p.parseGroup(FilterOr)
+		if err != nil {
+			return FilterNode{}, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return FilterNode{}, fmt.Errorf("Missing closing bracket in filter statement")
+		}
+		p.pos++
+		return node, nil
+	}
+
+	id, err := strconv.Atoi(token)
+	if err != nil {
+		return FilterNode{}, fmt.Errorf("Unexpected %q in filter statement", token)
+	}
+	criterion, ok := p.criteria[id]
+	if !ok {
+		return FilterNode{}, fmt.Errorf("Filter statement references unknown criterion %d", id)
+	}
+	return FilterNode{Criterion: &criterion}, nil
+}
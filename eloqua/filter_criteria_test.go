@@ -0,0 +1,96 @@
+package eloqua
+
+import (
+	"testing"
+)
+
+func TestSetFilterTree(t *testing.T) {
+	country := NewContactFieldCriterion(100001, TextCondition("equal", "UK"))
+	score := NewContactFieldCriterion(100002, NumericCondition("greaterThan", 50))
+	existing := NewContactFieldCriterion(100003, DateCondition("after", 1500000000))
+	existing.ID = -2
+
+	filter := &ContactFilter{}
+	err := filter.SetFilterTree(FilterAll(
+		FilterMatch(country),
+		FilterAny(FilterMatch(score), FilterMatch(existing)),
+	))
+	if err != nil {
+		t.Fatalf("SetFilterTree recieved error: %v", err)
+	}
+
+	if filter.Statement != "-3 AND ( -4 OR -2 )" {
+		t.Errorf("SetFilterTree statement not as expected, Recieved: %s", filter.Statement)
+	}
+
+	country.ID, score.ID = -3, -4
+	testModels(t, "SetFilterTree criteria", filter.Criteria, []FilterCriterion{country, score, existing})
+
+	if filter.Criteria[1].Condition.Value != "50" || filter.Criteria[2].Condition.Value != "1500000000" {
+		t.Errorf("SetFilterTree condition values not as expected, Recieved: %+v", filter.Criteria)
+	}
+}
+
+func TestSetFilterTreeErrors(t *testing.T) {
+	filter := &ContactFilter{}
+
+	if err := filter.SetFilterTree(FilterAll()); err == nil {
+		t.Error("SetFilterTree expected error for an empty group")
+	}
+	if err := filter.SetFilterTree(FilterNode{Operator: "XOR", Nodes: []FilterNode{{}}}); err == nil {
+		t.Error("SetFilterTree expected error for an invalid operator")
+	}
+}
+
+func TestFilterTree(t *testing.T) {
+	criteria := []FilterCriterion{{ID: -1, FieldID: 1}, {ID: -2, FieldID: 2}, {ID: -3, FieldID: 3}, {ID: -4, FieldID: 4}}
+	filter := &ContactFilter{Statement: "-1 AND (-2 OR ( -3 OR -4 ))", Criteria: criteria}
+
+	tree, err := filter.FilterTree()
+	if err != nil {
+		t.Fatalf("FilterTree recieved error: %v", err)
+	}
+
+	want := FilterAll(
+		FilterMatch(criteria[0]),
+		FilterAny(FilterMatch(criteria[1]), FilterMatch(criteria[2]), FilterMatch(criteria[3])),
+	)
+	testModels(t, "FilterTree", tree, want)
+
+	// Round trip the tree back into a statement
+	rebuilt := &ContactFilter{}
+	if err := rebuilt.SetFilterTree(tree); err != nil {
+		t.Fatalf("SetFilterTree recieved error: %v", err)
+	}
+	if rebuilt.Statement != "-1 AND ( -2 OR -3 OR -4 )" {
+		t.Errorf("SetFilterTree statement not as expected, Recieved: %s", rebuilt.Statement)
+	}
+}
+
+func TestFilterTreePrecedence(t *testing.T) {
+	criteria := []FilterCriterion{{ID: 1}, {ID: 2}, {ID: 3}}
+	filter := &ContactFilter{Statement: "1 OR 2 AND 3", Criteria: criteria}
+
+	tree, err := filter.FilterTree()
+	if err != nil {
+		t.Fatalf("FilterTree recieved error: %v", err)
+	}
+
+	want := FilterAny(
+		FilterMatch(criteria[0]),
+		FilterAll(FilterMatch(criteria[1]), FilterMatch(criteria[2])),
+	)
+	testModels(t, "FilterTree precedence", tree, want)
+}
+
+func TestFilterTreeErrors(t *testing.T) {
+	criteria := []FilterCriterion{{ID: -1}, {ID: -2}}
+
+	statements := []string{"", "-1 AND", "(-1 OR -2", "-1 -2", "-1 AND -5", "-1 XOR -2"}
+	for _, statement := range statements {
+		filter := &ContactFilter{Statement: statement, Criteria: criteria}
+		if _, err := filter.FilterTree(); err == nil {
+			t.Errorf("FilterTree expected error for statement %q", statement)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -18,25 +19,47 @@ type FormData struct {
 	Name                 string       `json:"name,omitempty"`
 	ID                   int          `json:"id,omitempty,string"`
 	FieldValues          []FieldValue `json:"fieldValues,omitempty"`
-	SubmittedAt          int          `json:"submittedAt,omitempty,string"`
+	SubmittedAt          Timestamp    `json:"submittedAt,omitempty"`
 	SubmittedByContactID int          `json:"submittedByContactId,omitempty,string"`
 }
 
 // Create a new form record in eloqua
 func (e *FormDataService) Create(formID int, formData *FormData) (*FormData, *Response, error) {
+	return e.CreateWithContext(context.Background(), formID, formData)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *FormDataService) CreateWithContext(ctx context.Context, formID int, formData *FormData) (*FormData, *Response, error) {
 	if formData == nil {
 		formData = &FormData{}
 	}
 
 	endpoint := fmt.Sprintf("/data/form/%d", formID)
-	resp, err := e.client.postRequestDecode(endpoint, formData)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, formData)
 	return formData, resp, err
 }
 
 // List many eloqua form records
 func (e *FormDataService) List(formID int, opts *ListOptions) ([]FormData, *Response, error) {
+	return e.ListWithContext(context.Background(), formID, opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *FormDataService) ListWithContext(ctx context.Context, formID int, opts *ListOptions) ([]FormData, *Response, error) {
 	endpoint := fmt.Sprintf("/data/form/%d", formID)
 	formDatas := new([]FormData)
-	resp, err := e.client.getRequestListDecode(endpoint, formDatas, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, formDatas, opts)
 	return *formDatas, resp, err
 }
+
+// ListPager returns a Pager to iterate over every page of eloqua form records
+func (e *FormDataService) ListPager(formID int, opts *ListOptions) *Pager[FormData] {
+	return newPager(opts, func(ctx context.Context, opts *ListOptions) ([]FormData, *Response, error) {
+		return e.ListWithContext(ctx, formID, opts)
+	})
+}
+
+// ListAll lists every eloqua form records, Requesting each page in turn
+func (e *FormDataService) ListAll(ctx context.Context, formID int, opts *ListOptions) ([]FormData, error) {
+	return e.ListPager(formID, opts).All(ctx)
+}
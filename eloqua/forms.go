@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -17,13 +18,13 @@ type Form struct {
 	Type          string      `json:"Type,omitempty"`
 	CurrentStatus string      `json:"currentStatus,omitempty"`
 	ID            int         `json:"id,omitempty,string"`
-	CreatedAt     int         `json:"createdAt,omitempty,string"`
+	CreatedAt     Timestamp   `json:"createdAt,omitempty"`
 	CreatedBy     int         `json:"createdBy,omitempty,string"`
 	Depth         string      `json:"depth,omitempty"`
 	FolderID      int         `json:"folderId,omitempty,string"`
 	Name          string      `json:"name,omitempty"`
 	Permissions   []string    `json:"permissions,omitempty"`
-	UpdatedAt     int         `json:"updatedAt,omitempty,string"`
+	UpdatedAt     Timestamp   `json:"updatedAt,omitempty"`
 	UpdatedBy     int         `json:"updatedBy,omitempty,string"`
 	FormFields    []FormField `json:"elements,omitempty"`
 
@@ -82,47 +83,82 @@ type FormStep struct {
 
 // Create a new form in eloqua
 func (e *FormService) Create(name string, form *Form) (*Form, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, form)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *FormService) CreateWithContext(ctx context.Context, name string, form *Form) (*Form, *Response, error) {
 	if form == nil {
 		form = &Form{}
 	}
 	form.Name = name
 	endpoint := "/assets/form"
-	resp, err := e.client.postRequestDecode(endpoint, form)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, form)
 	return form, resp, err
 }
 
 // Get an form object via its ID
 func (e *FormService) Get(id int) (*Form, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *FormService) GetWithContext(ctx context.Context, id int) (*Form, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/form/%d?depth=complete", id)
 	form := &Form{}
-	resp, err := e.client.getRequestDecode(endpoint, form)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, form)
 	return form, resp, err
 }
 
 // List many Eloqua form objetcs
 func (e *FormService) List(opts *ListOptions) ([]Form, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *FormService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Form, *Response, error) {
 	endpoint := "/assets/forms"
 	forms := new([]Form)
-	resp, err := e.client.getRequestListDecode(endpoint, forms, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, forms, opts)
 	return *forms, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua form objects
+func (e *FormService) ListPager(opts *ListOptions) *Pager[Form] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua form objects, Requesting each page in turn
+func (e *FormService) ListAll(ctx context.Context, opts *ListOptions) ([]Form, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing form in eloqua
 func (e *FormService) Update(id int, name string, form *Form) (*Form, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, form)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *FormService) UpdateWithContext(ctx context.Context, id int, name string, form *Form) (*Form, *Response, error) {
 	if form == nil {
 		form = &Form{}
 	}
 	form.ID = id
 	form.Name = name
 	endpoint := fmt.Sprintf("/assets/form/%d", form.ID)
-	resp, err := e.client.putRequestDecode(endpoint, form)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, form)
 	return form, resp, err
 }
 
 // Delete an existing form from eloqua
 func (e *FormService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *FormService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	form := &Form{ID: id}
 	endpoint := fmt.Sprintf("/assets/form/%d", form.ID)
-	resp, err := e.client.deleteRequest(endpoint, form)
+	resp, err := e.client.deleteRequest(ctx, endpoint, form)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -15,52 +16,82 @@ type ImageService struct {
 // Image represents an Eloqua image object.
 // Images are often in other Eloqua models such as Emails & Landing Pages
 type Image struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	CreatedBy int    `json:"createdBy,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-	Name      string `json:"name,omitempty"`
-	FolderID  int    `json:"folderId,omitempty,string"`
-
-	UpdatedAt    int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy    int      `json:"updatedBy,omitempty,string"`
-	Permissions  []string `json:"permissions,omitempty"`
-	FullImageURL string   `json:"fullImageUrl,omitempty"`
-	Size         Size     `json:"size,omitempty"`
-	ThumbnailURL string   `json:"thumbnailUrl,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	CreatedBy int       `json:"createdBy,omitempty,string"`
+	Depth     string    `json:"depth,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	FolderID  int       `json:"folderId,omitempty,string"`
+
+	UpdatedAt    Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy    int       `json:"updatedBy,omitempty,string"`
+	Permissions  []string  `json:"permissions,omitempty"`
+	FullImageURL string    `json:"fullImageUrl,omitempty"`
+	Size         Size      `json:"size,omitempty"`
+	ThumbnailURL string    `json:"thumbnailUrl,omitempty"`
 }
 
 // Create a new image in eloqua
 func (e *ImageService) Create(name string, image *Image) (*Image, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, image)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *ImageService) CreateWithContext(ctx context.Context, name string, image *Image) (*Image, *Response, error) {
 	if image == nil {
 		image = &Image{}
 	}
 	image.Name = name
 
 	endpoint := "/assets/image"
-	resp, err := e.client.postRequestDecode(endpoint, image)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, image)
 	return image, resp, err
 }
 
 // Get an image object via its ID
 func (e *ImageService) Get(id int) (*Image, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *ImageService) GetWithContext(ctx context.Context, id int) (*Image, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/image/%d?depth=complete", id)
 	image := &Image{}
-	resp, err := e.client.getRequestDecode(endpoint, image)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, image)
 	return image, resp, err
 }
 
 // List many eloqua images
 func (e *ImageService) List(opts *ListOptions) ([]Image, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *ImageService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Image, *Response, error) {
 	endpoint := "/assets/images"
 	images := new([]Image)
-	resp, err := e.client.getRequestListDecode(endpoint, images, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, images, opts)
 	return *images, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua images
+func (e *ImageService) ListPager(opts *ListOptions) *Pager[Image] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua images, Requesting each page in turn
+func (e *ImageService) ListAll(ctx context.Context, opts *ListOptions) ([]Image, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing image in eloqua
 func (e *ImageService) Update(id int, name string, image *Image) (*Image, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, image)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *ImageService) UpdateWithContext(ctx context.Context, id int, name string, image *Image) (*Image, *Response, error) {
 	if image == nil {
 		image = &Image{}
 	}
@@ -69,14 +100,19 @@ func (e *ImageService) Update(id int, name string, image *Image) (*Image, *Respo
 	image.Name = name
 
 	endpoint := fmt.Sprintf("/assets/image/%d", image.ID)
-	resp, err := e.client.putRequestDecode(endpoint, image)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, image)
 	return image, resp, err
 }
 
 // Delete an existing image from eloqua
 func (e *ImageService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *ImageService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	image := &Image{ID: id}
 	endpoint := fmt.Sprintf("/assets/image/%d", image.ID)
-	resp, err := e.client.deleteRequest(endpoint, image)
+	resp, err := e.client.deleteRequest(ctx, endpoint, image)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -17,18 +18,18 @@ type LandingPage struct {
 	Type                string           `json:"type,omitempty"`
 	CurrentStatus       string           `json:"currentStatus,omitempty"`
 	ID                  int              `json:"id,omitempty,string"`
-	CreatedAt           int              `json:"createdAt,omitempty,string"`
+	CreatedAt           Timestamp        `json:"createdAt,omitempty"`
 	CreatedBy           int              `json:"createdBy,omitempty,string"`
 	Depth               string           `json:"depth,omitempty"`
 	FolderID            int              `json:"folderId,omitempty,string"`
 	Name                string           `json:"name,omitempty"`
 	Permissions         []string         `json:"permissions,omitempty"`
-	UpdatedAt           int              `json:"updatedAt,omitempty,string"`
+	UpdatedAt           Timestamp        `json:"updatedAt,omitempty"`
 	UpdatedBy           int              `json:"updatedBy,omitempty,string"`
 	AutoRedirectURL     string           `json:"autoRedirectURL,omitempty"`
 	AutoRedirectWaitFor int              `json:"autoRedirectWaitFor,omitempty,string"`
 	ContentSections     []ContentSection `json:"contentSections,omitempty"`
-	DeployedAt          int              `json:"deployedAt,omitempty,string"`
+	DeployedAt          Timestamp        `json:"deployedAt,omitempty"`
 	DynamicContents     []DynamicContent `json:"dynamicContents,omitempty"`
 	Forms               []Form           `json:"forms,omitempty"`
 	HTMLContent         HTMLContent      `json:"htmlContent,omitempty"`
@@ -37,7 +38,7 @@ type LandingPage struct {
 	Layout              string           `json:"layout,omitempty"`
 	MicrositeID         int              `json:"micrositeId,omitempty,string"`
 	Style               string           `json:"style,omitempty"`
-	RefreshedAt         int              `json:"refreshedAt,omitempty,string"`
+	RefreshedAt         Timestamp        `json:"refreshedAt,omitempty"`
 	RelativePath        string           `json:"relativePath,omitempty"`
 
 	IsContentProtected        bool `json:"isContentProtected,omitempty,string"`
@@ -46,47 +47,82 @@ type LandingPage struct {
 
 // Create a new landingPage in eloqua
 func (e *LandingPageService) Create(name string, landingPage *LandingPage) (*LandingPage, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, landingPage)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *LandingPageService) CreateWithContext(ctx context.Context, name string, landingPage *LandingPage) (*LandingPage, *Response, error) {
 	if landingPage == nil {
 		landingPage = &LandingPage{}
 	}
 	landingPage.Name = name
 	endpoint := "/assets/landingPage"
-	resp, err := e.client.postRequestDecode(endpoint, landingPage)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, landingPage)
 	return landingPage, resp, err
 }
 
 // Get an landingPage object via its ID
 func (e *LandingPageService) Get(id int) (*LandingPage, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *LandingPageService) GetWithContext(ctx context.Context, id int) (*LandingPage, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/landingPage/%d?depth=complete", id)
 	landingPage := &LandingPage{}
-	resp, err := e.client.getRequestDecode(endpoint, landingPage)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, landingPage)
 	return landingPage, resp, err
 }
 
 // List many Eloqua landingPage objetcs
 func (e *LandingPageService) List(opts *ListOptions) ([]LandingPage, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *LandingPageService) ListWithContext(ctx context.Context, opts *ListOptions) ([]LandingPage, *Response, error) {
 	endpoint := "/assets/landingPages"
 	landingPages := new([]LandingPage)
-	resp, err := e.client.getRequestListDecode(endpoint, landingPages, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, landingPages, opts)
 	return *landingPages, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua landingPage objects
+func (e *LandingPageService) ListPager(opts *ListOptions) *Pager[LandingPage] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua landingPage objects, Requesting each page in turn
+func (e *LandingPageService) ListAll(ctx context.Context, opts *ListOptions) ([]LandingPage, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing landingPage in eloqua
 func (e *LandingPageService) Update(id int, name string, landingPage *LandingPage) (*LandingPage, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, landingPage)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *LandingPageService) UpdateWithContext(ctx context.Context, id int, name string, landingPage *LandingPage) (*LandingPage, *Response, error) {
 	if landingPage == nil {
 		landingPage = &LandingPage{}
 	}
 	landingPage.ID = id
 	landingPage.Name = name
 	endpoint := fmt.Sprintf("/assets/landingPage/%d", landingPage.ID)
-	resp, err := e.client.putRequestDecode(endpoint, landingPage)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, landingPage)
 	return landingPage, resp, err
 }
 
 // Delete an existing landingPage from eloqua
 func (e *LandingPageService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *LandingPageService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	landingPage := &LandingPage{ID: id}
 	endpoint := fmt.Sprintf("/assets/landingPage/%d", landingPage.ID)
-	resp, err := e.client.deleteRequest(endpoint, landingPage)
+	resp, err := e.client.deleteRequest(ctx, endpoint, landingPage)
 	return resp, err
 }
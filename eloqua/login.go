@@ -0,0 +1,123 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+)
+
+// defaultLoginURL is the Eloqua endpoint used to discover the base URLs for the
+// authenticated user's instance unless overridden with WithLoginURL.
+const defaultLoginURL = "https://login.eloqua.com/id"
+
+// LoginInfo represents the details returned by the Eloqua login endpoint
+// for the authenticated user, Including the base URLs of their instance.
+type LoginInfo struct {
+	Site LoginSite `json:"site"`
+	User LoginUser `json:"user"`
+	URLs LoginURLs `json:"urls"`
+}
+
+// LoginSite is the Eloqua instance (or site) the user belongs to.
+type LoginSite struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// LoginUser is the authenticated Eloqua user.
+type LoginUser struct {
+	ID           int    `json:"id"`
+	UserName     string `json:"username"`
+	DisplayName  string `json:"displayName"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// LoginURLs contains the base URL of the Eloqua instance along with
+// the URL templates for each of its APIs.
+type LoginURLs struct {
+	Base string       `json:"base"`
+	APIs LoginAPIURLs `json:"apis"`
+}
+
+// LoginAPIURLs contains the URL templates for the Eloqua SOAP & REST APIs.
+// Templates contain a '{version}' placeholder for the API version.
+type LoginAPIURLs struct {
+	SOAP struct {
+		Standard       string `json:"standard"`
+		DataTransfer   string `json:"dataTransfer"`
+		Email          string `json:"email"`
+		ExternalAction string `json:"externalAction"`
+	} `json:"soap"`
+	Rest struct {
+		Standard string `json:"standard"`
+		Data     string `json:"data"`
+		Bulk     string `json:"bulk"`
+	} `json:"rest"`
+}
+
+// ExpandURLTemplate replaces the '{version}' placeholder of an Eloqua URL template
+// with the given API version, For example "2.0".
+func ExpandURLTemplate(template string, version string) string {
+	return strings.Replace(template, "{version}", version, -1)
+}
+
+// GetLoginInfo requests the login details of the user authenticated by auth
+// from the Eloqua login endpoint.
+func GetLoginInfo(ctx context.Context, auth Authenticator, opts ...ClientOption) (*LoginInfo, error) {
+	return NewClientWithAuthenticator("", auth, opts...).getLoginInfo(ctx)
+}
+
+// getLoginInfo requests the login details of the client's user from the Eloqua login endpoint.
+func (c *Client) getLoginInfo(ctx context.Context) (*LoginInfo, error) {
+	resp, err := c.do(ctx, "GET", c.loginURL, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Eloqua responds with a plain "Not authenticated." string when login fails
+	info := &LoginInfo{}
+	if err := json.Unmarshal(content, info); err != nil || info.URLs.Base == "" {
+		return nil, errors.New("Eloqua login failed: " + strings.Trim(string(content), " \""))
+	}
+
+	return info, nil
+}
+
+// NewClientFromLogin creates a new Eloqua client using basic authentication,
+// Discovering the base URL of the instance via the Eloqua login endpoint
+// rather than requiring it to be provided.
+func NewClientFromLogin(ctx context.Context, companyName string, userName string, password string, opts ...ClientOption) (*Client, error) {
+	return discoverClient(ctx, NewClient("", companyName, userName, password, opts...))
+}
+
+// NewClientFromLoginWithAuthenticator creates a new Eloqua client that uses the given
+// Authenticator, Discovering the base URL of the instance via the Eloqua login endpoint.
+func NewClientFromLoginWithAuthenticator(ctx context.Context, auth Authenticator, opts ...ClientOption) (*Client, error) {
+	return discoverClient(ctx, NewClientWithAuthenticator("", auth, opts...))
+}
+
+// discoverClient sets the base URL of the given client from its login details.
+func discoverClient(ctx context.Context, c *Client) (*Client, error) {
+	info, err := c.getLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.BaseURL = strings.Trim(info.URLs.Base, " /")
+	c.Login = info
+	return c, nil
+}
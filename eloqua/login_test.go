@@ -0,0 +1,91 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testLoginResponse is a login endpoint response pointing at the test server
+func testLoginResponse() string {
+	return fmt.Sprintf(`{"site":{"id":42,"name":"TestCompany"},"user":{"id":314,"username":"John.Smith","displayName":"John Smith","firstName":"John","lastName":"Smith","emailAddress":"john@example.com"},"urls":{"base":"%[1]s","apis":{"soap":{"standard":"%[1]s/API/{version}/Service.svc"},"rest":{"standard":"%[1]s/API/REST/{version}/","data":"%[1]s/API/REST/{version}/","bulk":"%[1]s/API/Bulk/{version}/"}}}}`, server.URL)
+}
+
+// setupLogin returns an option pointing the login endpoint at the test server
+func setupLogin() ClientOption {
+	return WithLoginURL(server.URL + "/id")
+}
+
+func TestNewClientFromLogin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/id", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		if auth := req.Header.Get("Authorization"); auth != client.authHeader {
+			t.Errorf("Login request authorization not as expected, Recieved: %s", auth)
+		}
+		fmt.Fprint(w, testLoginResponse())
+	})
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	loginClient, err := NewClientFromLogin(context.Background(), "TestCompany", "John.Smith", "mysecret", setupLogin())
+	if err != nil {
+		t.Fatalf("NewClientFromLogin recieved error: %v", err)
+	}
+
+	if loginClient.BaseURL != server.URL {
+		t.Errorf("Client base URL not as expected.\nExpected: %s\nRecieved: %s", server.URL, loginClient.BaseURL)
+	}
+	if loginClient.Login.Site.Name != "TestCompany" || loginClient.Login.User.ID != 314 {
+		t.Errorf("Client login info not as expected, Recieved: %+v", loginClient.Login)
+	}
+
+	bulkURL := ExpandURLTemplate(loginClient.Login.URLs.APIs.Rest.Bulk, "2.0")
+	if bulkURL != server.URL+"/API/Bulk/2.0/" {
+		t.Errorf("Expanded bulk URL not as expected, Recieved: %s", bulkURL)
+	}
+
+	if _, _, err := loginClient.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get on discovered client recieved error: %v", err)
+	}
+}
+
+func TestNewClientFromLoginWithAuthenticator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/id", func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "Bearer access" {
+			t.Errorf("Login request authorization not as expected, Recieved: %s", auth)
+		}
+		fmt.Fprint(w, testLoginResponse())
+	})
+
+	auth := testOAuthConfig().NewAuthenticator(&Token{AccessToken: "access"})
+	loginClient, err := NewClientFromLoginWithAuthenticator(context.Background(), auth, setupLogin())
+	if err != nil {
+		t.Fatalf("NewClientFromLoginWithAuthenticator recieved error: %v", err)
+	}
+	if loginClient.BaseURL != server.URL {
+		t.Errorf("Client base URL not as expected, Recieved: %s", loginClient.BaseURL)
+	}
+}
+
+func TestNewClientFromLoginNotAuthenticated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/id", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `"Not authenticated."`)
+	})
+
+	_, err := NewClientFromLogin(context.Background(), "TestCompany", "John.Smith", "wrong", setupLogin())
+	if err == nil || err.Error() != "Eloqua login failed: Not authenticated." {
+		t.Errorf("Expected login failure error, Recieved: %v", err)
+	}
+}
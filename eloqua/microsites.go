@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -15,13 +16,13 @@ type MicrositeService struct {
 // Microsite represents an Eloqua microsite object.
 // Microsites are often in other Eloqua models such as Emails & Landing Pages
 type Microsite struct {
-	Type      string `json:"type,omitempty"`
-	ID        int    `json:"id,omitempty,string"`
-	CreatedAt int    `json:"createdAt,omitempty,string"`
-	CreatedBy int    `json:"createdBy,omitempty,string"`
-	Depth     string `json:"depth,omitempty"`
-	Name      string `json:"name,omitempty"`
-	UpdatedAt int    `json:"updatedAt,omitempty,string"`
+	Type      string    `json:"type,omitempty"`
+	ID        int       `json:"id,omitempty,string"`
+	CreatedAt Timestamp `json:"createdAt,omitempty"`
+	CreatedBy int       `json:"createdBy,omitempty,string"`
+	Depth     string    `json:"depth,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updatedAt,omitempty"`
 
 	Domains                []string `json:"domains,omitempty"`
 	EnableWebTrackingOptIn string   `json:"enableWebTrackingOptIn,omitempty"`
@@ -31,34 +32,64 @@ type Microsite struct {
 
 // Create a new microsite in eloqua
 func (e *MicrositeService) Create(name string, microsite *Microsite) (*Microsite, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, microsite)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *MicrositeService) CreateWithContext(ctx context.Context, name string, microsite *Microsite) (*Microsite, *Response, error) {
 	if microsite == nil {
 		microsite = &Microsite{}
 	}
 	microsite.Name = name
 
 	endpoint := "/assets/microsite"
-	resp, err := e.client.postRequestDecode(endpoint, microsite)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, microsite)
 	return microsite, resp, err
 }
 
 // Get an microsite object via its ID
 func (e *MicrositeService) Get(id int) (*Microsite, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *MicrositeService) GetWithContext(ctx context.Context, id int) (*Microsite, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/microsite/%d?depth=complete", id)
 	microsite := &Microsite{}
-	resp, err := e.client.getRequestDecode(endpoint, microsite)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, microsite)
 	return microsite, resp, err
 }
 
 // List many eloqua microsites
 func (e *MicrositeService) List(opts *ListOptions) ([]Microsite, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *MicrositeService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Microsite, *Response, error) {
 	endpoint := "/assets/microsites"
 	microsites := new([]Microsite)
-	resp, err := e.client.getRequestListDecode(endpoint, microsites, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, microsites, opts)
 	return *microsites, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua microsites
+func (e *MicrositeService) ListPager(opts *ListOptions) *Pager[Microsite] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua microsites, Requesting each page in turn
+func (e *MicrositeService) ListAll(ctx context.Context, opts *ListOptions) ([]Microsite, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing microsite in eloqua
 func (e *MicrositeService) Update(id int, name string, microsite *Microsite) (*Microsite, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, microsite)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *MicrositeService) UpdateWithContext(ctx context.Context, id int, name string, microsite *Microsite) (*Microsite, *Response, error) {
 	if microsite == nil {
 		microsite = &Microsite{}
 	}
@@ -67,14 +98,19 @@ func (e *MicrositeService) Update(id int, name string, microsite *Microsite) (*M
 	microsite.Name = name
 
 	endpoint := fmt.Sprintf("/assets/microsite/%d", microsite.ID)
-	resp, err := e.client.putRequestDecode(endpoint, microsite)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, microsite)
 	return microsite, resp, err
 }
 
 // Delete an existing microsite from eloqua
 func (e *MicrositeService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *MicrositeService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	microsite := &Microsite{ID: id}
 	endpoint := fmt.Sprintf("/assets/microsite/%d", microsite.ID)
-	resp, err := e.client.deleteRequest(endpoint, microsite)
+	resp, err := e.client.deleteRequest(ctx, endpoint, microsite)
 	return resp, err
 }
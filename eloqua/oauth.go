@@ -0,0 +1,233 @@
+package eloqua
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultOAuthURL is the base URL of the Eloqua OAuth 2.0 endpoints.
+const DefaultOAuthURL = "https://login.eloqua.com/auth/oauth2"
+
+// tokenExpiryDelta is how long before its actual expiry a token is treated as
+// expired, so that requests are not sent with a token that is about to lapse.
+const tokenExpiryDelta = 60 * time.Second
+
+// OAuthConfig holds the details of an Eloqua App Cloud (or other OAuth) application
+// used to obtain access tokens from the Eloqua token endpoint.
+type OAuthConfig struct {
+	// The client ID of your Eloqua app
+	ClientID string
+	// The client secret of your Eloqua app
+	ClientSecret string
+	// The URL Eloqua should redirect to after authorization.
+	// This must match the redirect URL registered for the app.
+	RedirectURL string
+	// The requested scope, Defaults to "full"
+	Scope string
+	// The base URL of the OAuth endpoints, Defaults to DefaultOAuthURL.
+	// Used to point the config to a local server when testing.
+	AuthURL string
+	// The HTTP client used for token requests, Defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// Token is an Eloqua OAuth 2.0 token response.
+// Tokens can be marshalled to JSON to persist them between runs.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// The time at which the access token expires, Calculated from ExpiresIn
+	// when the token is received. A zero Expiry means the token does not expire.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has an access token that has not expired.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+func (c *OAuthConfig) authURL() string {
+	if c.AuthURL == "" {
+		return DefaultOAuthURL
+	}
+	return strings.TrimRight(c.AuthURL, " /")
+}
+
+func (c *OAuthConfig) scope() string {
+	if c.Scope == "" {
+		return "full"
+	}
+	return c.Scope
+}
+
+func (c *OAuthConfig) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// AuthCodeURL returns the URL to send a user to in order to grant your app access
+// to their Eloqua instance, as the first step of the authorization code grant.
+// The state value is returned to the redirect URL unchanged.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", c.ClientID)
+	v.Set("redirect_uri", c.RedirectURL)
+	v.Set("scope", c.scope())
+	if state != "" {
+		v.Set("state", state)
+	}
+	return c.authURL() + "/authorize?" + v.Encode()
+}
+
+// Exchange converts an authorization code, received on the redirect URL,
+// into an access token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.requestToken(ctx, map[string]string{
+		"grant_type":   "authorization_code",
+		"code":         code,
+		"redirect_uri": c.RedirectURL,
+	})
+}
+
+// PasswordCredentialsToken obtains an access token using the resource owner password
+// credentials grant with the given Eloqua login details.
+func (c *OAuthConfig) PasswordCredentialsToken(ctx context.Context, companyName string, userName string, password string) (*Token, error) {
+	return c.requestToken(ctx, map[string]string{
+		"grant_type": "password",
+		"scope":      c.scope(),
+		"username":   companyName + "\\" + userName,
+		"password":   password,
+	})
+}
+
+// Refresh obtains a new access token using the given refresh token.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return c.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+		"scope":         c.scope(),
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// requestToken posts the given grant to the token endpoint and decodes the returned token.
+func (c *OAuthConfig) requestToken(ctx context.Context, grant map[string]string) (*Token, error) {
+	body, err := json.Marshal(grant)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.authURL()+"/token", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.ClientID, c.ClientSecret)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("OAuth token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(content)))
+	}
+
+	token := &Token{}
+	if err := json.Unmarshal(content, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("OAuth token response did not contain an access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// OAuthAuthenticator authenticates requests with an OAuth 2.0 bearer token,
+// refreshing the access token when it has expired.
+type OAuthAuthenticator struct {
+	config *OAuthConfig
+
+	mu    sync.Mutex
+	token *Token
+
+	// OnTokenRefresh, If set, is called with the new token each time the access
+	// token is refreshed. Use this to persist refreshed tokens.
+	// An error returned here fails the request being authenticated.
+	OnTokenRefresh func(token *Token) error
+}
+
+// NewAuthenticator creates an OAuthAuthenticator using the given token,
+// Which will be refreshed via this config when it expires.
+func (c *OAuthConfig) NewAuthenticator(token *Token) *OAuthAuthenticator {
+	return &OAuthAuthenticator{config: c, token: token}
+}
+
+// Token returns the current token in use by the authenticator.
+func (a *OAuthAuthenticator) Token() *Token {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token
+}
+
+// Authenticate sets the bearer authorization header on the request,
+// refreshing the access token first if it has expired.
+func (a *OAuthAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.token.Valid() {
+		if a.token == nil || a.token.RefreshToken == "" {
+			return errors.New("OAuth access token has expired and no refresh token is available")
+		}
+
+		token, err := a.config.Refresh(ctx, a.token.RefreshToken)
+		if err != nil {
+			return err
+		}
+		// Eloqua may not issue a new refresh token on every refresh
+		if token.RefreshToken == "" {
+			token.RefreshToken = a.token.RefreshToken
+		}
+		a.token = token
+
+		if a.OnTokenRefresh != nil {
+			if err := a.OnTokenRefresh(token); err != nil {
+				return err
+			}
+		}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+a.token.AccessToken)
+	return nil
+}
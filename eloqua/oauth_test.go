@@ -0,0 +1,196 @@
+package eloqua
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// testOAuthConfig creates an OAuth config pointing at the test server.
+func testOAuthConfig() *OAuthConfig {
+	return &OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+		AuthURL:      server.URL + "/auth/oauth2",
+	}
+}
+
+// testTokenRequest checks the token endpoint request is authorized with
+// the client credentials and returns the decoded grant.
+func testTokenRequest(t *testing.T, req *http.Request) map[string]string {
+	testMethod(t, req, "POST")
+	user, pass, ok := req.BasicAuth()
+	if !ok || user != "client-id" || pass != "client-secret" {
+		t.Errorf("Token request client credentials not as expected, Recieved: %s:%s", user, pass)
+	}
+	grant := map[string]string{}
+	json.NewDecoder(req.Body).Decode(&grant)
+	return grant
+}
+
+func TestOAuthAuthCodeURL(t *testing.T) {
+	config := &OAuthConfig{ClientID: "abc", RedirectURL: "https://example.com/callback"}
+
+	authURL, err := url.Parse(config.AuthCodeURL("xyz"))
+	if err != nil {
+		t.Fatalf("AuthCodeURL returned an invalid url: %v", err)
+	}
+
+	if authURL.Host != "login.eloqua.com" || authURL.Path != "/auth/oauth2/authorize" {
+		t.Errorf("AuthCodeURL location not as expected, Recieved: %s", authURL)
+	}
+
+	want := url.Values{
+		"response_type": {"code"},
+		"client_id":     {"abc"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"scope":         {"full"},
+		"state":         {"xyz"},
+	}
+	testModels(t, "OAuthConfig.AuthCodeURL query", authURL.Query(), want)
+}
+
+func TestOAuthExchange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/auth/oauth2/token", func(w http.ResponseWriter, req *http.Request) {
+		grant := testTokenRequest(t, req)
+		want := map[string]string{"grant_type": "authorization_code", "code": "the-code", "redirect_uri": "https://example.com/callback"}
+		testModels(t, "OAuthConfig.Exchange grant", grant, want)
+		fmt.Fprint(w, `{"access_token":"access","token_type":"bearer","expires_in":28800,"refresh_token":"refresh"}`)
+	})
+
+	token, err := testOAuthConfig().Exchange(context.Background(), "the-code")
+	if err != nil {
+		t.Fatalf("OAuthConfig.Exchange recieved error: %v", err)
+	}
+
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" || token.ExpiresIn != 28800 {
+		t.Errorf("OAuthConfig.Exchange token not as expected, Recieved: %+v", token)
+	}
+	if !token.Valid() {
+		t.Error("Newly received token should be valid")
+	}
+	if d := time.Until(token.Expiry); d < 7*time.Hour || d > 8*time.Hour {
+		t.Errorf("Token expiry not calculated from expires_in, Expires in %s", d)
+	}
+}
+
+func TestOAuthPasswordCredentialsToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/auth/oauth2/token", func(w http.ResponseWriter, req *http.Request) {
+		grant := testTokenRequest(t, req)
+		want := map[string]string{"grant_type": "password", "scope": "full", "username": "TestCompany\\John.Smith", "password": "mysecret"}
+		testModels(t, "OAuthConfig.PasswordCredentialsToken grant", grant, want)
+		fmt.Fprint(w, `{"access_token":"access","token_type":"bearer","expires_in":28800,"refresh_token":"refresh"}`)
+	})
+
+	token, err := testOAuthConfig().PasswordCredentialsToken(context.Background(), "TestCompany", "John.Smith", "mysecret")
+	if err != nil {
+		t.Fatalf("OAuthConfig.PasswordCredentialsToken recieved error: %v", err)
+	}
+	if token.AccessToken != "access" {
+		t.Errorf("Access token not as expected, Recieved: %s", token.AccessToken)
+	}
+}
+
+func TestOAuthTokenErrorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addCustomHandlerFunc("/auth/oauth2/token", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprint(w, `{"error":"invalid_grant"}`)
+	})
+
+	_, err := testOAuthConfig().Refresh(context.Background(), "old-refresh")
+	if err == nil {
+		t.Error("Expected an error due to a 400 token response but no error was returned")
+	}
+}
+
+func TestOAuthAuthenticatorRefresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	refreshes := 0
+	addCustomHandlerFunc("/auth/oauth2/token", func(w http.ResponseWriter, req *http.Request) {
+		refreshes++
+		grant := testTokenRequest(t, req)
+		want := map[string]string{"grant_type": "refresh_token", "refresh_token": "old-refresh", "scope": "full", "redirect_uri": "https://example.com/callback"}
+		testModels(t, "OAuthAuthenticator refresh grant", grant, want)
+		fmt.Fprint(w, `{"access_token":"new-access","token_type":"bearer","expires_in":28800}`)
+	})
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "Bearer new-access" {
+			t.Errorf("Authorization header not as expected, Recieved: %s", auth)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	expired := &Token{AccessToken: "old-access", RefreshToken: "old-refresh", Expiry: time.Now().Add(-time.Minute)}
+	auth := testOAuthConfig().NewAuthenticator(expired)
+
+	var persisted *Token
+	auth.OnTokenRefresh = func(token *Token) error {
+		persisted = token
+		return nil
+	}
+
+	oauthClient := NewClientWithAuthenticator(server.URL, auth)
+	if _, _, err := oauthClient.Contacts.Get(1); err != nil {
+		t.Fatalf("Contacts.Get recieved error: %v", err)
+	}
+	// The refreshed token should be reused for following requests
+	if _, _, err := oauthClient.Contacts.Get(1); err != nil {
+		t.Fatalf("Contacts.Get recieved error: %v", err)
+	}
+
+	if refreshes != 1 {
+		t.Errorf("Expected a single token refresh, Recieved %d", refreshes)
+	}
+	if persisted == nil || persisted.AccessToken != "new-access" {
+		t.Errorf("OnTokenRefresh not called with the refreshed token, Recieved: %+v", persisted)
+	}
+	if auth.Token().RefreshToken != "old-refresh" {
+		t.Error("Refresh token should be kept when a new one is not issued")
+	}
+}
+
+func TestOAuthAuthenticatorErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("Request should not be sent when authentication fails")
+	})
+
+	expired := &Token{AccessToken: "old-access", Expiry: time.Now().Add(-time.Minute)}
+	oauthClient := NewClientWithAuthenticator(server.URL, testOAuthConfig().NewAuthenticator(expired))
+	if _, _, err := oauthClient.Contacts.Get(1); err == nil {
+		t.Error("Expected an error due to an expired token without a refresh token")
+	}
+
+	addCustomHandlerFunc("/auth/oauth2/token", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"access_token":"new-access","expires_in":28800}`)
+	})
+
+	persistErr := errors.New("could not save token")
+	auth := testOAuthConfig().NewAuthenticator(&Token{RefreshToken: "refresh"})
+	auth.OnTokenRefresh = func(token *Token) error { return persistErr }
+
+	oauthClient = NewClientWithAuthenticator(server.URL, auth)
+	if _, _, err := oauthClient.Contacts.Get(1); err != persistErr {
+		t.Errorf("Expected OnTokenRefresh error to be returned, Recieved: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -32,35 +33,65 @@ type Option struct {
 
 // Create a new optionList in eloqua
 func (e *OptionListService) Create(name string, optionList *OptionList) (*OptionList, *Response, error) {
+	return e.CreateWithContext(context.Background(), name, optionList)
+}
+
+// CreateWithContext is like Create but performs the request with the given context.
+func (e *OptionListService) CreateWithContext(ctx context.Context, name string, optionList *OptionList) (*OptionList, *Response, error) {
 	if optionList == nil {
 		optionList = &OptionList{}
 	}
 	optionList.Name = name
 
 	endpoint := "/assets/optionList"
-	resp, err := e.client.postRequestDecode(endpoint, optionList)
+	resp, err := e.client.postRequestDecode(ctx, endpoint, optionList)
 	return optionList, resp, err
 }
 
 // Get an optionList object via its ID
 func (e *OptionListService) Get(id int) (*OptionList, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *OptionListService) GetWithContext(ctx context.Context, id int) (*OptionList, *Response, error) {
 	endpoint := fmt.Sprintf("/assets/optionList/%d?depth=complete", id)
 	optionList := &OptionList{}
-	resp, err := e.client.getRequestDecode(endpoint, optionList)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, optionList)
 	return optionList, resp, err
 }
 
 // List many eloqua optionLists
 func (e *OptionListService) List(opts *ListOptions) ([]OptionList, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *OptionListService) ListWithContext(ctx context.Context, opts *ListOptions) ([]OptionList, *Response, error) {
 	endpoint := "/assets/optionLists"
 	optionLists := new([]OptionList)
-	resp, err := e.client.getRequestListDecode(endpoint, optionLists, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, optionLists, opts)
 	return *optionLists, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of eloqua optionLists
+func (e *OptionListService) ListPager(opts *ListOptions) *Pager[OptionList] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua optionLists, Requesting each page in turn
+func (e *OptionListService) ListAll(ctx context.Context, opts *ListOptions) ([]OptionList, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing optionList in eloqua
 // Updating will delete all current options.
 func (e *OptionListService) Update(id int, name string, optionList *OptionList) (*OptionList, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, optionList)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *OptionListService) UpdateWithContext(ctx context.Context, id int, name string, optionList *OptionList) (*OptionList, *Response, error) {
 	if optionList == nil {
 		optionList = &OptionList{}
 	}
@@ -69,14 +100,19 @@ func (e *OptionListService) Update(id int, name string, optionList *OptionList)
 	optionList.Name = name
 
 	endpoint := fmt.Sprintf("/assets/optionList/%d", optionList.ID)
-	resp, err := e.client.putRequestDecode(endpoint, optionList)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, optionList)
 	return optionList, resp, err
 }
 
 // Delete an existing optionList from eloqua
 func (e *OptionListService) Delete(id int) (*Response, error) {
+	return e.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but performs the request with the given context.
+func (e *OptionListService) DeleteWithContext(ctx context.Context, id int) (*Response, error) {
 	optionList := &OptionList{ID: id}
 	endpoint := fmt.Sprintf("/assets/optionList/%d", optionList.ID)
-	resp, err := e.client.deleteRequest(endpoint, optionList)
+	resp, err := e.client.deleteRequest(ctx, endpoint, optionList)
 	return resp, err
 }
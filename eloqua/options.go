@@ -0,0 +1,55 @@
+package eloqua
+
+import (
+	"net/http"
+)
+
+// defaultUserAgent is the user agent sent with requests unless overridden.
+const defaultUserAgent = "go-eloqua"
+
+// ClientOption customises a Client when it is created.
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests, allowing
+// timeouts, proxies & TLS settings to be configured.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+	}
+}
+
+// WithTransport sets the round tripper used to send requests, Such as an
+// instrumented or logging transport. The HTTP client in use is copied rather
+// than modified, so this can be safely used along with WithHTTPClient.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		httpClient := *c.client
+		httpClient.Transport = transport
+		c.client = &httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header to be sent with each request.
+// Content-Type and authorization headers are always set by the client.
+func WithHeader(key string, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithLoginURL sets the Eloqua login endpoint used to discover the base URLs
+// of the instance, Such as a local server when testing.
+func WithLoginURL(loginURL string) ClientOption {
+	return func(c *Client) {
+		c.loginURL = loginURL
+	}
+}
@@ -0,0 +1,83 @@
+package eloqua
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientDefaultUserAgent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		if ua := req.Header.Get("User-Agent"); ua != defaultUserAgent {
+			t.Errorf("User agent not as expected.\nExpected: %s\nRecieved: %s", defaultUserAgent, ua)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	if _, _, err := client.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		if ua := req.Header.Get("User-Agent"); ua != "my-app/1.0" {
+			t.Errorf("User agent not as expected, Recieved: %s", ua)
+		}
+		if values := req.Header["X-Request-Source"]; len(values) != 2 || values[0] != "sync" || values[1] != "nightly" {
+			t.Errorf("Default headers not as expected, Recieved: %v", values)
+		}
+		if auth := req.Header.Get("Authorization"); auth != client.authHeader {
+			t.Errorf("Authorization header should not be overridden, Recieved: %s", auth)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	}
+	addRestHandlerFunc("/data/contact/1", handler)
+	addCustomHandlerFunc("/custom/contact", handler)
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	transport := &countingTransport{}
+
+	optClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret",
+		WithHTTPClient(httpClient),
+		WithTransport(transport),
+		WithUserAgent("my-app/1.0"),
+		WithHeader("X-Request-Source", "sync"),
+		WithHeader("X-Request-Source", "nightly"),
+		WithHeader("Authorization", "ignored"),
+	)
+
+	if _, _, err := optClient.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+	if _, err := optClient.CustomJSONRequest(server.URL+"/custom/contact", "GET", ""); err != nil {
+		t.Errorf("CustomJSONRequest recieved error: %v", err)
+	}
+
+	if transport.count != 2 {
+		t.Errorf("Expected 2 requests via the custom transport, Recieved %d", transport.count)
+	}
+	if optClient.client.Timeout != httpClient.Timeout {
+		t.Error("Client timeout should be kept when setting a transport")
+	}
+	if httpClient.Transport != nil {
+		t.Error("The provided HTTP client should not be modified by WithTransport")
+	}
+}
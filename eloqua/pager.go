@@ -0,0 +1,201 @@
+package eloqua
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// ErrNoMorePages is returned by Pager.Next when every page has been read.
+var ErrNoMorePages = errors.New("There are no more pages to list")
+
+// listFunc requests a single page of entities from a listing endpoint.
+type listFunc[T any] func(ctx context.Context, opts *ListOptions) ([]T, *Response, error)
+
+// pageResult is the result of a single page request.
+type pageResult[T any] struct {
+	items []T
+	resp  *Response
+	err   error
+}
+
+// Pager pages through the results of an Eloqua listing endpoint.
+// Pagers are created via the ListPager method of each listable service.
+//
+//	pager := client.Contacts.ListPager(&eloqua.ListOptions{Count: 500})
+//	for pager.More() {
+//		contacts, resp, err := pager.Next(ctx)
+//		...
+//	}
+//
+// Alternatively Items provides an iterator over every entity.
+//
+// Prefetched pages are requested with a context owned by the pager rather than
+// the context given to Next, Call Close to cancel them when stopping early.
+// Calls to Next are serialised, so a Pager can be shared between goroutines,
+// however each page is only returned to one caller.
+type Pager[T any] struct {
+	// The number of following pages to request concurrently while
+	// the current page is processed. Zero disables prefetching.
+	// Prefetching begins once the first page has been received.
+	Prefetch int
+
+	list listFunc[T]
+	opts ListOptions
+
+	// Context for prefetched requests, Cancelled by Close
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// Held for the duration of each call to Next
+	next sync.Mutex
+
+	mu       sync.Mutex
+	page     int
+	lastPage int
+	done     bool
+	pending  map[int]chan pageResult[T]
+}
+
+// newPager creates a Pager starting at the page given in opts, or the first page.
+func newPager[T any](opts *ListOptions, list listFunc[T]) *Pager[T] {
+	p := &Pager[T]{list: list, page: 1, pending: map[int]chan pageResult[T]{}}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	if opts != nil {
+		p.opts = *opts
+		if opts.Page > 0 {
+			p.page = opts.Page
+		}
+	}
+	return p
+}
+
+// More reports whether there are more pages to request.
+func (p *Pager[T]) More() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.done
+}
+
+// Close cancels any prefetched requests and stops the pager,
+// After which Next returns ErrNoMorePages.
+func (p *Pager[T]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done = true
+	p.pending = map[int]chan pageResult[T]{}
+	p.cancel()
+}
+
+// Next requests the next page of entities.
+// ErrNoMorePages is returned once every page has been read or the pager is closed.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, *Response, error) {
+	p.next.Lock()
+	defer p.next.Unlock()
+
+	p.mu.Lock()
+	if p.done {
+		p.mu.Unlock()
+		return nil, nil, ErrNoMorePages
+	}
+	page := p.page
+	result, ok := p.pending[page]
+	delete(p.pending, page)
+	p.mu.Unlock()
+
+	var res pageResult[T]
+	if ok {
+		select {
+		case res = <-result:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	} else {
+		res = p.fetch(ctx, page)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.done {
+		return nil, nil, ErrNoMorePages
+	}
+	if res.err != nil {
+		return res.items, res.resp, res.err
+	}
+
+	p.page++
+	if res.resp != nil && res.resp.PageSize > 0 {
+		p.lastPage = (res.resp.Total + res.resp.PageSize - 1) / res.resp.PageSize
+	}
+	if len(res.items) == 0 || (p.lastPage > 0 && p.page > p.lastPage) || res.resp == nil || res.resp.PageSize == 0 {
+		p.done = true
+	}
+
+	if p.done {
+		p.cancel()
+	} else {
+		p.prefetch()
+	}
+
+	return res.items, res.resp, nil
+}
+
+// fetch requests the given page.
+func (p *Pager[T]) fetch(ctx context.Context, page int) pageResult[T] {
+	opts := p.opts
+	opts.Page = page
+	items, resp, err := p.list(ctx, &opts)
+	return pageResult[T]{items: items, resp: resp, err: err}
+}
+
+// prefetch starts requests for upcoming pages that are not already pending
+// using the pager's context. Must be called with the lock held.
+func (p *Pager[T]) prefetch() {
+	for page := p.page; page < p.page+p.Prefetch && page <= p.lastPage; page++ {
+		if _, ok := p.pending[page]; ok {
+			continue
+		}
+		result := make(chan pageResult[T], 1)
+		p.pending[page] = result
+		go func(page int) {
+			result <- p.fetch(p.ctx, page)
+		}(page)
+	}
+}
+
+// Items returns an iterator over every entity on the remaining pages.
+// Iteration stops after the first error, Which is yielded with a zero-valued entity.
+// The pager is closed once iteration ends.
+func (p *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer p.Close()
+		for p.More() {
+			items, _, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All requests every remaining page and returns all of their entities.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for item, err := range p.Items(ctx) {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
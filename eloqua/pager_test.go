@@ -0,0 +1,233 @@
+package eloqua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// addPagedContactsHandler serves total contacts over pages of the requested size,
+// recording the pages requested.
+func addPagedContactsHandler(t *testing.T, total int) *[]int {
+	var mu sync.Mutex
+	requested := []int{}
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		testMethod(t, req, "GET")
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		count, _ := strconv.Atoi(req.URL.Query().Get("count"))
+
+		mu.Lock()
+		requested = append(requested, page)
+		mu.Unlock()
+
+		elements := ""
+		for id := (page-1)*count + 1; id <= page*count && id <= total; id++ {
+			if elements != "" {
+				elements += ","
+			}
+			elements += fmt.Sprintf(`{"type":"Contact","id":"%d"}`, id)
+		}
+		fmt.Fprintf(w, `{"elements":[%s],"page":%d,"pageSize":%d,"total":%d}`, elements, page, count, total)
+	})
+
+	return &requested
+}
+
+func TestPagerNext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := addPagedContactsHandler(t, 5)
+	pager := client.Contacts.ListPager(&ListOptions{Count: 2})
+
+	var pageSizes []int
+	for pager.More() {
+		contacts, _, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Pager.Next recieved error: %v", err)
+		}
+		pageSizes = append(pageSizes, len(contacts))
+	}
+
+	testModels(t, "Pager page sizes", pageSizes, []int{2, 2, 1})
+	testModels(t, "Pager requested pages", *requested, []int{1, 2, 3})
+
+	if _, _, err := pager.Next(context.Background()); err != ErrNoMorePages {
+		t.Errorf("Expected ErrNoMorePages after the last page, Recieved: %v", err)
+	}
+}
+
+func TestPagerItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addPagedContactsHandler(t, 5)
+
+	ids := []int{}
+	for contact, err := range client.Contacts.ListPager(&ListOptions{Count: 2}).Items(context.Background()) {
+		if err != nil {
+			t.Fatalf("Pager.Items recieved error: %v", err)
+		}
+		ids = append(ids, contact.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	testModels(t, "Pager.Items ids", ids, []int{1, 2, 3})
+}
+
+func TestContactListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addPagedContactsHandler(t, 5)
+
+	contacts, err := client.Contacts.ListAll(context.Background(), &ListOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("Contacts.ListAll recieved error: %v", err)
+	}
+	if len(contacts) != 5 || contacts[4].ID != 5 {
+		t.Errorf("Contacts.ListAll did not return every contact, Recieved: %+v", contacts)
+	}
+}
+
+func TestPagerPrefetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := addPagedContactsHandler(t, 10)
+
+	pager := client.Contacts.ListPager(&ListOptions{Count: 2})
+	pager.Prefetch = 3
+
+	contacts, err := pager.All(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.All recieved error: %v", err)
+	}
+
+	for i, contact := range contacts {
+		if contact.ID != i+1 {
+			t.Fatalf("Prefetched contacts out of order, Recieved id %d at index %d", contact.ID, i)
+		}
+	}
+	if len(contacts) != 10 || len(*requested) != 5 {
+		t.Errorf("Expected 10 contacts from 5 requests, Recieved %d contacts from %d requests", len(contacts), len(*requested))
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/form/5", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(500)
+	})
+
+	_, err := client.FormData.ListAll(context.Background(), 5, nil)
+	if err == nil {
+		t.Error("Expected an error due to a 500 response")
+	}
+}
+
+func TestPagerPrefetchOutlivesNextContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := addPagedContactsHandler(t, 6)
+
+	pager := client.Contacts.ListPager(&ListOptions{Count: 2})
+	pager.Prefetch = 2
+	defer pager.Close()
+
+	// Prefetched pages must not be cancelled along with the context of the first call
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, _, err := pager.Next(ctx); err != nil {
+		t.Fatalf("Pager.Next recieved error: %v", err)
+	}
+	cancel()
+
+	contacts, err := pager.All(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.All recieved error: %v", err)
+	}
+	if len(contacts) != 4 || len(*requested) != 3 {
+		t.Errorf("Expected 4 contacts from 3 requests, Recieved %d contacts from %d requests", len(contacts), len(*requested))
+	}
+}
+
+func TestPagerClose(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addPagedContactsHandler(t, 10)
+
+	pager := client.Contacts.ListPager(&ListOptions{Count: 2})
+	pager.Prefetch = 3
+
+	if _, _, err := pager.Next(context.Background()); err != nil {
+		t.Fatalf("Pager.Next recieved error: %v", err)
+	}
+	pager.Close()
+
+	if pager.More() {
+		t.Error("Expected no more pages once the pager is closed")
+	}
+	if _, _, err := pager.Next(context.Background()); err != ErrNoMorePages {
+		t.Errorf("Expected ErrNoMorePages once the pager is closed, Recieved: %v", err)
+	}
+	if pager.ctx.Err() == nil {
+		t.Error("Expected the prefetch context to be cancelled once the pager is closed")
+	}
+}
+
+func TestPagerConcurrentNext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addPagedContactsHandler(t, 20)
+
+	pager := client.Contacts.ListPager(&ListOptions{Count: 2})
+	pager.Prefetch = 2
+	defer pager.Close()
+
+	var mu sync.Mutex
+	seen := map[int]int{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pager.More() {
+				contacts, _, err := pager.Next(context.Background())
+				if err == ErrNoMorePages {
+					return
+				}
+				if err != nil {
+					t.Errorf("Pager.Next recieved error: %v", err)
+					return
+				}
+				mu.Lock()
+				for _, contact := range contacts {
+					seen[contact.ID]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 20 {
+		t.Errorf("Expected all 20 contacts, Recieved %d", len(seen))
+	}
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("Contact %d returned %d times", id, count)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package eloqua
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles requests made to Eloqua using a token bucket for
+// the request rate and a semaphore for the number of concurrent requests.
+// A single RateLimiter can be shared between many clients so that they are
+// throttled together against the limits of one Eloqua instance.
+type RateLimiter struct {
+	// Token bucket state
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// Concurrency semaphore, nil when concurrency is unlimited
+	sem chan struct{}
+
+	// Stats
+	requests  int64
+	waits     int64
+	totalWait time.Duration
+	inFlight  int
+}
+
+// RateLimitStats is a snapshot of a RateLimiter's activity for monitoring.
+type RateLimitStats struct {
+	// Total requests that have passed through the limiter
+	Requests int64
+	// Number of requests that had to wait for the rate or concurrency limit
+	Waits int64
+	// Total time requests have spent waiting
+	TotalWait time.Duration
+	// Requests currently in flight
+	InFlight int
+	// The maximum requests allowed in flight, Zero if unlimited
+	MaxConcurrent int
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond requests on average,
+// with bursts of up to burst requests, and at most maxConcurrent requests in flight.
+// A requestsPerSecond or maxConcurrent of zero disables that limit.
+func NewRateLimiter(requestsPerSecond float64, burst int, maxConcurrent int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+	if maxConcurrent > 0 {
+		l.sem = make(chan struct{}, maxConcurrent)
+	}
+
+	return l
+}
+
+// WithRateLimiter throttles all requests made by the client, across every service,
+// using the given RateLimiter.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// RateLimitStats returns the current stats of the client's rate limiter.
+// Zero-valued stats are returned if the client has no rate limiter.
+func (c *Client) RateLimitStats() RateLimitStats {
+	if c.rateLimiter == nil {
+		return RateLimitStats{}
+	}
+	return c.rateLimiter.Stats()
+}
+
+// Stats returns a snapshot of the limiter's activity.
+func (l *RateLimiter) Stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return RateLimitStats{
+		Requests:      l.requests,
+		Waits:         l.waits,
+		TotalWait:     l.totalWait,
+		InFlight:      l.inFlight,
+		MaxConcurrent: cap(l.sem),
+	}
+}
+
+// acquire waits until a request is allowed by both the rate and concurrency limits.
+// The returned func must be called once the request has completed.
+func (l *RateLimiter) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	waited := false
+
+	// Wait for a token from the bucket
+	if wait := l.reserve(); wait > 0 {
+		waited = true
+		if err := sleepContext(ctx, wait); err != nil {
+			l.unreserve()
+			return nil, err
+		}
+	}
+
+	// Wait for a free concurrency slot
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		default:
+			waited = true
+			select {
+			case l.sem <- struct{}{}:
+			case <-ctx.Done():
+				l.unreserve()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	l.mu.Lock()
+	l.requests++
+	l.inFlight++
+	if waited {
+		l.waits++
+		l.totalWait += time.Since(start)
+	}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.inFlight--
+			l.mu.Unlock()
+			if l.sem != nil {
+				<-l.sem
+			}
+		})
+	}, nil
+}
+
+// releaseOnClose is a response body that frees its request's concurrency slot
+// once closed, So the slot is held while the body is still being read.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the underlying body and releases the concurrency slot.
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// reserve takes a token from the bucket, Returning how long to wait until
+// the token is available. Tokens may go negative to queue waiting requests in order.
+func (l *RateLimiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// unreserve returns a token taken by a request that was cancelled while waiting.
+func (l *RateLimiter) unreserve() {
+	if l.rate <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	l.tokens++
+	l.mu.Unlock()
+}
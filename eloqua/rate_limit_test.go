@@ -0,0 +1,176 @@
+package eloqua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterConcurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	limiter := NewRateLimiter(0, 0, 2)
+	limitedClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRateLimiter(limiter))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := limitedClient.Contacts.Get(1); err != nil {
+				t.Errorf("Contacts.Get recieved error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("Expected at most 2 requests in flight, Recieved %d", maxInFlight)
+	}
+
+	stats := limitedClient.RateLimitStats()
+	if stats.Requests != 6 || stats.InFlight != 0 || stats.MaxConcurrent != 2 {
+		t.Errorf("Rate limit stats not as expected, Recieved: %+v", stats)
+	}
+	if stats.Waits == 0 {
+		t.Error("Expected some requests to wait for a concurrency slot")
+	}
+}
+
+func TestRateLimiterRate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	// A burst of 2 then 1 request every 50ms
+	limiter := NewRateLimiter(20, 2, 0)
+	limitedClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRateLimiter(limiter))
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, _, err := limitedClient.Contacts.Get(1); err != nil {
+			t.Errorf("Contacts.Get recieved error: %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Requests were not rate limited, 4 requests took %s", elapsed)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 4 || stats.Waits != 2 || stats.TotalWait <= 0 {
+		t.Errorf("Rate limit stats not as expected, Recieved: %+v", stats)
+	}
+}
+
+func TestRateLimiterSharedBetweenClients(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	limiter := NewRateLimiter(0, 0, 1)
+	clientA := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRateLimiter(limiter))
+	clientB := NewClient(server.URL, "TestCompany", "Jane.Smith", "mysecret", WithRateLimiter(limiter))
+
+	clientA.Contacts.Get(1)
+	clientB.Contacts.Get(1)
+
+	if stats := limiter.Stats(); stats.Requests != 2 {
+		t.Errorf("Expected both clients to use the shared limiter, Recieved %d requests", stats.Requests)
+	}
+}
+
+func TestRateLimiterContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	limiter := NewRateLimiter(0.001, 1, 0)
+	limitedClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRateLimiter(limiter))
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	// Use the only token in the bucket
+	limitedClient.Contacts.Get(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, _, err := limitedClient.Contacts.GetWithContext(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+	if stats := limiter.Stats(); stats.Requests != 1 {
+		t.Errorf("Cancelled request should not be counted, Recieved %d requests", stats.Requests)
+	}
+}
+
+func TestRateLimiterHeldUntilBodyClosed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	limiter := NewRateLimiter(0, 0, 1)
+	limitedClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRateLimiter(limiter))
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	resp, err := limitedClient.RestRequest("/data/contact/1", "GET", "")
+	if err != nil {
+		t.Fatalf("RestRequest recieved error: %v", err)
+	}
+	if stats := limiter.Stats(); stats.InFlight != 1 {
+		t.Errorf("Expected the request to be in flight until its body is closed, Recieved %d", stats.InFlight)
+	}
+
+	resp.Body.Close()
+	resp.Body.Close()
+	if stats := limiter.Stats(); stats.InFlight != 0 {
+		t.Errorf("Expected no requests in flight once the body is closed, Recieved %d", stats.InFlight)
+	}
+
+	if _, _, err := limitedClient.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+}
+
+func TestRateLimitStatsWithoutLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if stats := client.RateLimitStats(); stats != (RateLimitStats{}) {
+		t.Errorf("Expected zero stats without a rate limiter, Recieved: %+v", stats)
+	}
+}
@@ -0,0 +1,214 @@
+package eloqua
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Renderer renders the HTML of emails & landing pages offline as a given contact would see it,
+// Substituting field merges & choosing the content of each dynamic content section.
+//
+// Field merges are recognised by their syntax within either a
+// <span class="eloquaemail">Syntax</span> element or an <eloqua type="emailfield" syntax="Syntax"/> tag.
+// Dynamic content is recognised by an <eloqua type="DynamicContent" id="ID"/> tag.
+//
+// Contact field values are read from the contact's FieldValues by ID. Standard fields,
+// Such as the email address, Are only available when Fields is set so that their IDs
+// can be matched to the contact's properties.
+type Renderer struct {
+	// Contact field definitions used to resolve standard fields by ID
+	Fields *FieldSet
+	// Additional field merges, Such as those used by landing pages
+	Merges []FieldMerge
+}
+
+// NewRenderer creates a Renderer resolving standard contact fields with the given field definitions,
+// Which may be nil.
+func NewRenderer(fields *FieldSet) *Renderer {
+	return &Renderer{Fields: fields}
+}
+
+var (
+	rendererSpanMerge = regexp.MustCompile(`(?is)<span[^>]*class\s*=\s*["']?eloquaemail["']?[^>]*>(.*?)</span>`)
+	rendererTag       = regexp.MustCompile(`(?is)<eloqua\s([^>]*?)/?>(?:\s*</eloqua>)?`)
+	rendererAttribute = regexp.MustCompile(`(?is)([a-z-]+)\s*=\s*["']([^"']*)["']`)
+)
+
+// RenderEmail renders the email's HTML as the given contact would receive it.
+func (r *Renderer) RenderEmail(email *Email, contact *Contact) (string, error) {
+	merges := append(append([]FieldMerge{}, email.FieldMerges...), r.Merges...)
+	return r.render(email.HTMLContent.HTML, merges, email.DynamicContents, contact)
+}
+
+// RenderLandingPage renders the landing page's HTML as the given contact would see it.
+func (r *Renderer) RenderLandingPage(page *LandingPage, contact *Contact) (string, error) {
+	return r.render(page.HTMLContent.HTML, r.Merges, page.DynamicContents, contact)
+}
+
+// render substitutes the dynamic content & then the field merges within content
+func (r *Renderer) render(content string, merges []FieldMerge, dynamicContents []DynamicContent, contact *Contact) (string, error) {
+	if contact == nil {
+		contact = &Contact{}
+	}
+
+	bySyntax := make(map[string]FieldMerge, len(merges))
+	for _, merge := range merges {
+		bySyntax[strings.ToLower(merge.Syntax)] = merge
+	}
+
+	byID := make(map[int]DynamicContent, len(dynamicContents))
+	for _, dc := range dynamicContents {
+		byID[dc.ID] = dc
+	}
+
+	var renderErr error
+	fail := func(err error) {
+		if renderErr == nil {
+			renderErr = err
+		}
+	}
+
+	// Dynamic content is substituted first as its sections may contain field merges
+	content = rendererTag.ReplaceAllStringFunc(content, func(tag string) string {
+		attrs := rendererAttributes(rendererTag.FindStringSubmatch(tag)[1])
+		if !strings.EqualFold(attrs["type"], "DynamicContent") {
+			return tag
+		}
+
+		id, _ := strconv.Atoi(attrs["id"])
+		dc, ok := byID[id]
+		if !ok {
+			fail(fmt.Errorf("Dynamic content %q not found", attrs["id"]))
+			return ""
+		}
+
+		section, err := r.dynamicContentSection(dc, contact)
+		if err != nil {
+			fail(err)
+			return ""
+		}
+		return section.ContentHTML
+	})
+
+	content = rendererTag.ReplaceAllStringFunc(content, func(tag string) string {
+		attrs := rendererAttributes(rendererTag.FindStringSubmatch(tag)[1])
+		if !strings.EqualFold(attrs["type"], "emailfield") {
+			return tag
+		}
+		return r.mergeValue(bySyntax, attrs["syntax"], contact, fail)
+	})
+
+	content = rendererSpanMerge.ReplaceAllStringFunc(content, func(span string) string {
+		syntax := strings.TrimSpace(rendererSpanMerge.FindStringSubmatch(span)[1])
+		return r.mergeValue(bySyntax, syntax, contact, fail)
+	})
+
+	return content, renderErr
+}
+
+// rendererAttributes parses the attributes of an HTML tag, With lower case names
+func rendererAttributes(s string) map[string]string {
+	attrs := map[string]string{}
+	for _, match := range rendererAttribute.FindAllStringSubmatch(s, -1) {
+		attrs[strings.ToLower(match[1])] = match[2]
+	}
+	return attrs
+}
+
+// mergeValue returns the HTML escaped value of the field merge with the given syntax,
+// Or its default value if the contact has no value for the field.
+func (r *Renderer) mergeValue(bySyntax map[string]FieldMerge, syntax string, contact *Contact, fail func(error)) string {
+	merge, ok := bySyntax[strings.ToLower(syntax)]
+	if !ok {
+		fail(fmt.Errorf("Field merge %q not found", syntax))
+		return ""
+	}
+
+	value := ""
+	if merge.ContactFieldID != 0 {
+		value = r.contactFieldValue(contact, merge.ContactFieldID)
+	}
+	if value == "" {
+		value = merge.DefaultValue
+	}
+	return html.EscapeString(value)
+}
+
+// contactFieldValue returns the value of the contact field with the given ID, Empty if unknown
+func (r *Renderer) contactFieldValue(contact *Contact, fieldID int) string {
+	for _, fv := range contact.FieldValues {
+		if fv.ID == fieldID {
+			return fv.Value
+		}
+	}
+
+	if r.Fields == nil {
+		return ""
+	}
+	field, ok := r.Fields.LookupID(fieldID)
+	if !ok {
+		return ""
+	}
+	if get, ok := standardContactFields[strings.ToLower(field.InternalName)]; ok {
+		return get(contact)
+	}
+	return ""
+}
+
+// dynamicContentSection returns the section of the first rule matching the contact, Or the default section.
+// A rule matches when the criterion its statement refers to matches, Or with no statement when all its criteria match.
+func (r *Renderer) dynamicContentSection(dc DynamicContent, contact *Contact) (DynamicContentSection, error) {
+	for _, rule := range dc.Rules {
+		matched, evaluated := true, 0
+		for _, criterion := range rule.Criteria {
+			if rule.Statement != 0 && criterion.ID != rule.Statement {
+				continue
+			}
+			ok, err := r.criterionMatches(criterion, contact)
+			if err != nil {
+				return DynamicContentSection{}, err
+			}
+			matched = matched && ok
+			evaluated++
+		}
+		if matched && evaluated > 0 {
+			return rule.ContentSection, nil
+		}
+	}
+	return dc.DefaultContentSection, nil
+}
+
+// criterionMatches evaluates a text value condition against the contact's field value.
+// Text comparisons are case-insensitive as they are in Eloqua.
+func (r *Renderer) criterionMatches(criterion ContactFieldCriterion, contact *Contact) (bool, error) {
+	value := r.contactFieldValue(contact, criterion.FieldID)
+	value = strings.ToLower(strings.TrimSpace(value))
+	expected := strings.ToLower(strings.TrimSpace(criterion.Condition.Value))
+
+	switch criterion.Condition.Operator {
+	case "equal":
+		return value == expected, nil
+	case "notEqual":
+		return value != expected, nil
+	case "contains":
+		return strings.Contains(value, expected), nil
+	case "notContains":
+		return !strings.Contains(value, expected), nil
+	case "startsWith":
+		return strings.HasPrefix(value, expected), nil
+	case "notStartsWith":
+		return !strings.HasPrefix(value, expected), nil
+	case "endsWith":
+		return strings.HasSuffix(value, expected), nil
+	case "notEndsWith":
+		return !strings.HasSuffix(value, expected), nil
+	case "blank":
+		return value == "", nil
+	case "notBlank":
+		return value != "", nil
+	}
+	return false, fmt.Errorf("Unsupported dynamic content condition operator %q", criterion.Condition.Operator)
+}
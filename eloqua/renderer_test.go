@@ -0,0 +1,116 @@
+package eloqua
+
+import (
+	"strings"
+	"testing"
+)
+
+func testRendererEmail() *Email {
+	return &Email{
+		HTMLContent: HTMLContent{HTML: `<p>Hi <span class="eloquaemail">FirstName1</span>,</p>` +
+			`<eloqua type="DynamicContent" id="3" />` +
+			`<p>Score: <eloqua type="emailfield" syntax="LeadScore" /></p>`},
+		FieldMerges: []FieldMerge{
+			{Syntax: "FirstName1", ContactFieldID: 100002, DefaultValue: "there"},
+			{Syntax: "LeadScore", ContactFieldID: 100200, DefaultValue: "0"},
+			{Syntax: "Region", ContactFieldID: 100201},
+		},
+		DynamicContents: []DynamicContent{
+			{
+				ID: 3,
+				Rules: []DynamicContentRule{
+					{
+						Statement: -1,
+						Criteria: []ContactFieldCriterion{
+							{ID: -1, FieldID: 100201, Condition: TextValueCondition{Operator: "equal", Value: "emea"}},
+							{ID: -2, FieldID: 100201, Condition: TextValueCondition{Operator: "unknown"}},
+						},
+						ContentSection: DynamicContentSection{ContentHTML: `<p>Europe in <span class="eloquaemail">Region</span></p>`},
+					},
+					{
+						Criteria: []ContactFieldCriterion{
+							{FieldID: 100001, Condition: TextValueCondition{Operator: "endsWith", Value: "@example.com"}},
+							{FieldID: 100201, Condition: TextValueCondition{Operator: "notBlank"}},
+						},
+						ContentSection: DynamicContentSection{ContentHTML: "<p>Example staff</p>"},
+					},
+				},
+				DefaultContentSection: DynamicContentSection{ContentHTML: "<p>Default</p>"},
+			},
+		},
+	}
+}
+
+func TestRendererRenderEmail(t *testing.T) {
+	fields := NewFieldSet([]FieldDefinition{
+		{ID: 100001, InternalName: "C_EmailAddress"},
+		{ID: 100002, InternalName: "C_FirstName"},
+	})
+	renderer := NewRenderer(fields)
+
+	cases := []struct {
+		contact *Contact
+		want    string
+	}{
+		{
+			&Contact{FirstName: "Jane & Co", FieldValues: []FieldValue{{ID: 100201, Value: "EMEA"}, {ID: 100200, Value: "42"}}},
+			`<p>Hi Jane &amp; Co,</p><p>Europe in EMEA</p><p>Score: 42</p>`,
+		},
+		{
+			&Contact{EmailAddress: "bob@Example.com", FieldValues: []FieldValue{{ID: 100201, Value: "APAC"}}},
+			`<p>Hi there,</p><p>Example staff</p><p>Score: 0</p>`,
+		},
+		{
+			nil,
+			`<p>Hi there,</p><p>Default</p><p>Score: 0</p>`,
+		},
+	}
+
+	for _, c := range cases {
+		out, err := renderer.RenderEmail(testRendererEmail(), c.contact)
+		if err != nil {
+			t.Fatalf("Renderer.RenderEmail recieved error: %v", err)
+		}
+		if out != c.want {
+			t.Errorf("Renderer.RenderEmail not as expected\nRecieved: %s\nWanted:   %s", out, c.want)
+		}
+	}
+}
+
+func TestRendererWithoutFields(t *testing.T) {
+	out, err := NewRenderer(nil).RenderEmail(testRendererEmail(), &Contact{FirstName: "Jane"})
+	if err != nil {
+		t.Fatalf("Renderer.RenderEmail recieved error: %v", err)
+	}
+	if !strings.HasPrefix(out, "<p>Hi there,</p>") {
+		t.Errorf("Renderer.RenderEmail expected the default value for a standard field, Recieved: %s", out)
+	}
+}
+
+func TestRendererRenderLandingPage(t *testing.T) {
+	renderer := &Renderer{Merges: []FieldMerge{{Syntax: "City", ContactFieldID: 100300}}}
+	page := &LandingPage{HTMLContent: HTMLContent{HTML: `<h1><eloqua type="emailfield" syntax="city"></eloqua></h1>`}}
+
+	out, err := renderer.RenderLandingPage(page, &Contact{FieldValues: []FieldValue{{ID: 100300, Value: "London"}}})
+	if err != nil {
+		t.Fatalf("Renderer.RenderLandingPage recieved error: %v", err)
+	}
+	if out != "<h1>London</h1>" {
+		t.Errorf("Renderer.RenderLandingPage not as expected, Recieved: %s", out)
+	}
+}
+
+func TestRendererErrors(t *testing.T) {
+	renderer := NewRenderer(nil)
+
+	page := &LandingPage{HTMLContent: HTMLContent{HTML: `<span class="eloquaemail">Missing</span><eloqua type="DynamicContent" id="9"/>`}}
+	if _, err := renderer.RenderLandingPage(page, &Contact{}); err == nil {
+		t.Error("Renderer.RenderLandingPage expected error for unknown merges & dynamic content")
+	}
+
+	email := testRendererEmail()
+	email.DynamicContents[0].Rules[0].Statement = -2
+	if _, err := renderer.RenderEmail(email, &Contact{}); err == nil || !strings.Contains(err.Error(), "unknown") {
+		t.Errorf("Renderer.RenderEmail expected error for an unsupported operator, Recieved: %v", err)
+	}
+}
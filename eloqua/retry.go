@@ -0,0 +1,153 @@
+package eloqua
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Requests are retried
+// when a network error occurs or Eloqua responds with one of the RetryStatusCodes,
+// Waiting with jittered exponential backoff between attempts. A Retry-After
+// header sent by Eloqua is honoured in place of the backoff.
+type RetryPolicy struct {
+	// The maximum number of retries after the initial attempt
+	MaxRetries int
+	// The backoff before the first retry, Doubled for each following retry
+	MinBackoff time.Duration
+	// The maximum backoff between attempts
+	MaxBackoff time.Duration
+	// The response status codes that will be retried
+	RetryStatusCodes []int
+	// Retry non-idempotent methods such as POST. By default only
+	// GET, HEAD, OPTIONS, PUT & DELETE requests are retried, as retrying
+	// other methods may cause duplicate records to be created.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy that retries idempotent requests up to
+// 3 times on network errors and 429, 502 & 503 responses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:       3,
+		MinBackoff:       500 * time.Millisecond,
+		MaxBackoff:       30 * time.Second,
+		RetryStatusCodes: []int{429, 502, 503},
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+// Requests are not retried unless a policy is set.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// idempotentMethods are the HTTP methods that can be safely retried
+var idempotentMethods = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+	"PUT":     true,
+	"DELETE":  true,
+}
+
+// shouldRetry reports whether the given attempt should be retried
+// and how long to wait before doing so.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !idempotentMethods[method] {
+		return 0, false
+	}
+
+	if err != nil {
+		// Errors caused by the context ending should not be retried
+		if ctx.Err() != nil {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !p.retryStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	if wait, ok := retryAfter(resp); ok {
+		return wait, true
+	}
+	return p.backoff(attempt), true
+}
+
+// retryStatus reports whether a response status code should be retried
+func (p *RetryPolicy) retryStatus(statusCode int) bool {
+	for _, code := range p.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the jittered exponential backoff for the given attempt.
+// The wait is a random duration between half and all of the capped exponential backoff.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.MinBackoff
+	for i := 0; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// retryAfter parses the Retry-After header of a response, Which can be
+// either a number of seconds or a HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration, Returning early with
+// the context's error if the context ends first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package eloqua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy is a retry policy with short backoffs for testing
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryOnStatusCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.WriteHeader(429)
+		case 2:
+			w.WriteHeader(502)
+		case 3:
+			w.WriteHeader(503)
+		default:
+			fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+		}
+	})
+
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(testRetryPolicy()))
+	contact, _, err := retryClient.Contacts.Get(1)
+	if err != nil {
+		t.Fatalf("Contacts.Get recieved error: %v", err)
+	}
+	if attempts != 4 || contact.ID != 1 {
+		t.Errorf("Expected 4 attempts and a decoded contact, Recieved %d attempts & %+v", attempts, contact)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(503)
+	})
+
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(testRetryPolicy()))
+	_, resp, err := retryClient.Contacts.Get(1)
+	if err == nil || resp.StatusCode != 503 {
+		t.Errorf("Expected a 503 error after retrying, Recieved: %v", err)
+	}
+	if attempts != 4 {
+		t.Errorf("Expected 4 attempts, Recieved %d", attempts)
+	}
+}
+
+func TestRetryOnlyIdempotentMethods(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(503)
+	})
+
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(testRetryPolicy()))
+	retryClient.Contacts.Create("test@example.com", nil)
+	if attempts != 1 {
+		t.Errorf("POST requests should not be retried by default, Recieved %d attempts", attempts)
+	}
+}
+
+func TestRetryReplaysBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	var firstBody string
+	addRestHandlerFunc("/data/contact", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(req.Body)
+		if attempts == 1 {
+			firstBody = string(body)
+		} else if string(body) != firstBody || len(body) == 0 {
+			t.Errorf("Request body not replayed on attempt %d.\nExpected: %s\nRecieved: %s", attempts, firstBody, body)
+		}
+		if attempts == 1 {
+			w.WriteHeader(429)
+			return
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"5","emailAddress":"test@example.com"}`)
+	})
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(policy))
+
+	if _, _, err := retryClient.Contacts.Create("test@example.com", nil); err != nil {
+		t.Errorf("Contacts.Create recieved error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, Recieved %d", attempts)
+	}
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var first time.Time
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		if first.IsZero() {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(429)
+			return
+		}
+		if waited := time.Since(first); waited < time.Second {
+			t.Errorf("Retry-After header not honoured, Retried after %s", waited)
+		}
+		fmt.Fprint(w, `{"type":"Contact","id":"1"}`)
+	})
+
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(testRetryPolicy()))
+	if _, _, err := retryClient.Contacts.Get(1); err != nil {
+		t.Errorf("Contacts.Get recieved error: %v", err)
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contact/1", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(503)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	retryClient := NewClient(server.URL, "TestCompany", "John.Smith", "mysecret", WithRetryPolicy(testRetryPolicy()))
+	_, _, err := retryClient.Contacts.GetWithContext(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded error, Recieved: %v", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 20; i++ {
+			if wait := policy.backoff(test.attempt); wait < test.min || wait > test.max {
+				t.Errorf("Backoff for attempt %d out of range, Recieved %s", test.attempt, wait)
+			}
+		}
+	}
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat))
+
+	wait, ok := retryAfter(resp)
+	if !ok || wait <= 0 || wait > 10*time.Second {
+		t.Errorf("Retry-After date not parsed as expected, Recieved %s", wait)
+	}
+}
@@ -0,0 +1,278 @@
+package eloqua
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchCondition is a condition of an Eloqua list search, Built with functions
+// such as SearchEqual & SearchAnd, For use as ListOptions.Search.
+//
+//	search := eloqua.SearchAnd(
+//		eloqua.SearchStartsWith("name", "Newsletter"),
+//		eloqua.SearchGreaterThan("updatedAt", eloqua.NewTimestamp(since)),
+//	)
+//	err := opts.SetSearch("Email", search)
+type SearchCondition struct {
+	expr     string
+	operator string
+	fields   []string
+	err      error
+}
+
+// searchValueEscaper escapes values so that they are matched literally.
+var searchValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `*`, `\*`)
+
+// searchPatternEscaper escapes patterns, Leaving '*' wildcards in place.
+var searchPatternEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// EscapeSearchValue escapes quotes, Backslashes & wildcards in value so that it
+// is matched literally by an Eloqua search.
+func EscapeSearchValue(value string) string {
+	return searchValueEscaper.Replace(value)
+}
+
+// formatSearchValue formats a value for use in a search condition.
+func formatSearchValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return EscapeSearchValue(v), nil
+	case Timestamp:
+		return strconv.FormatInt(int64(v), 10), nil
+	case time.Time:
+		return strconv.FormatInt(int64(NewTimestamp(v)), 10), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	return "", fmt.Errorf("Unsupported search value type %T", value)
+}
+
+// newSearchComparison builds a condition comparing a field to a value.
+func newSearchComparison(field string, operator string, value interface{}) SearchCondition {
+	c := SearchCondition{fields: []string{field}}
+	if field == "" || strings.ContainsAny(field, " '=<>!") {
+		c.err = fmt.Errorf("Invalid search field name %q", field)
+		return c
+	}
+
+	formatted, err := formatSearchValue(value)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.expr = fmt.Sprintf("%s%s'%s'", field, operator, formatted)
+	return c
+}
+
+// SearchEqual matches entities where field equals value.
+// Values may be strings, Timestamps, times, ints or bools, Strings are matched literally.
+func SearchEqual(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, "=", value)
+}
+
+// SearchNotEqual matches entities where field does not equal value.
+func SearchNotEqual(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, "!=", value)
+}
+
+// SearchGreaterThan matches entities where field is greater than value.
+func SearchGreaterThan(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, ">", value)
+}
+
+// SearchGreaterThanOrEqual matches entities where field is greater than or equal to value.
+func SearchGreaterThanOrEqual(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, ">=", value)
+}
+
+// SearchLessThan matches entities where field is less than value.
+func SearchLessThan(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, "<", value)
+}
+
+// SearchLessThanOrEqual matches entities where field is less than or equal to value.
+func SearchLessThanOrEqual(field string, value interface{}) SearchCondition {
+	return newSearchComparison(field, "<=", value)
+}
+
+// SearchLike matches entities where field matches pattern, In which '*' is a wildcard.
+// Quotes in the pattern are escaped but wildcards are kept.
+func SearchLike(field string, pattern string) SearchCondition {
+	return searchLike(field, searchPatternEscaper.Replace(pattern))
+}
+
+// SearchStartsWith matches entities where field starts with prefix.
+func SearchStartsWith(field string, prefix string) SearchCondition {
+	return searchLike(field, EscapeSearchValue(prefix)+"*")
+}
+
+// SearchEndsWith matches entities where field ends with suffix.
+func SearchEndsWith(field string, suffix string) SearchCondition {
+	return searchLike(field, "*"+EscapeSearchValue(suffix))
+}
+
+// SearchContains matches entities where field contains value.
+func SearchContains(field string, value string) SearchCondition {
+	return searchLike(field, "*"+EscapeSearchValue(value)+"*")
+}
+
+// searchLike builds a wildcard condition from an already escaped pattern.
+func searchLike(field string, pattern string) SearchCondition {
+	c := newSearchComparison(field, "=", "")
+	if c.err == nil {
+		c.expr = fmt.Sprintf("%s='%s'", field, pattern)
+	}
+	return c
+}
+
+// joinSearch combines conditions with the given operator.
+// Combined conditions of a different operator are wrapped in parentheses.
+func joinSearch(operator string, conditions []SearchCondition) SearchCondition {
+	joined := SearchCondition{operator: operator}
+	nonEmpty := []SearchCondition{}
+
+	for _, c := range conditions {
+		if c.err != nil && joined.err == nil {
+			joined.err = c.err
+		}
+		joined.fields = append(joined.fields, c.fields...)
+		if c.expr != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+
+	// A single condition is used as is
+	if len(nonEmpty) == 1 {
+		joined.expr = nonEmpty[0].expr
+		joined.operator = nonEmpty[0].operator
+		return joined
+	}
+
+	parts := make([]string, len(nonEmpty))
+	for i, c := range nonEmpty {
+		parts[i] = c.expr
+		if c.operator != "" && c.operator != operator {
+			parts[i] = "(" + c.expr + ")"
+		}
+	}
+	joined.expr = strings.Join(parts, " "+operator+" ")
+	return joined
+}
+
+// SearchAnd matches entities that match all of the given conditions.
+func SearchAnd(conditions ...SearchCondition) SearchCondition {
+	return joinSearch("AND", conditions)
+}
+
+// SearchOr matches entities that match at least one of the given conditions.
+func SearchOr(conditions ...SearchCondition) SearchCondition {
+	return joinSearch("OR", conditions)
+}
+
+// String returns the search string for use as ListOptions.Search.
+func (c SearchCondition) String() string {
+	return c.expr
+}
+
+// Err returns any error from building the condition, Such as an unsupported value type.
+func (c SearchCondition) Err() error {
+	return c.err
+}
+
+// Fields returns the names of the fields searched by the condition.
+func (c SearchCondition) Fields() []string {
+	return c.fields
+}
+
+// Validate checks the condition was built without error and that every field it searches
+// is searchable for the given entity type, Such as "Contact" or "Email".
+// Fields of unknown entity types are not checked.
+func (c SearchCondition) Validate(entityType string) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	fields, ok := SearchFields[entityType]
+	if !ok {
+		return nil
+	}
+
+	for _, field := range c.fields {
+		if !isSearchField(entityType, fields, field) {
+			return fmt.Errorf("%q is not a searchable %s field, Expected one of %s", field, entityType, strings.Join(sortedSearchFields(fields), ", "))
+		}
+	}
+	return nil
+}
+
+// SetSearch validates the condition for the given entity type and sets it as the search term.
+func (o *ListOptions) SetSearch(entityType string, condition SearchCondition) error {
+	if err := condition.Validate(entityType); err != nil {
+		return err
+	}
+	o.Search = condition.String()
+	return nil
+}
+
+// searchAssetFields are the fields searchable for every asset type.
+var searchAssetFields = []string{"id", "name", "createdAt", "createdBy", "updatedAt", "updatedBy", "folderId"}
+
+// SearchFields lists the searchable fields of each entity type, Used to validate search conditions.
+// Contact & account fields may also be searched by their internal names, Such as "C_EmailAddress".
+var SearchFields = map[string][]string{
+	"Contact":           {"id", "name", "emailAddress", "firstName", "lastName", "company", "createdAt", "updatedAt"},
+	"Account":           {"id", "name", "createdAt", "updatedAt"},
+	"CustomObject":      searchAssetFields,
+	"CustomObjectData":  {"id", "name", "uniqueCode", "contactId", "createdAt", "updatedAt"},
+	"Campaign":          append([]string{"currentStatus", "startAt", "endAt"}, searchAssetFields...),
+	"ContactField":      {"id", "name", "internalName", "dataType", "createdAt", "updatedAt"},
+	"ContactList":       searchAssetFields,
+	"ContactSegment":    searchAssetFields,
+	"ContentSection":    searchAssetFields,
+	"Email":             append([]string{"subject", "emailGroupId"}, searchAssetFields...),
+	"EmailFolder":       searchAssetFields,
+	"EmailFooter":       searchAssetFields,
+	"EmailGroup":        searchAssetFields,
+	"EmailHeader":       searchAssetFields,
+	"ExternalAssetType": searchAssetFields,
+	"ExternalAsset":     searchAssetFields,
+	"Form":              searchAssetFields,
+	"FormData":          {"id", "submittedAt", "submittedByContactId"},
+	"Image":             searchAssetFields,
+	"LandingPage":       append([]string{"relativePath", "micrositeId"}, searchAssetFields...),
+	"Microsite":         append([]string{"domains"}, searchAssetFields...),
+	"User":              {"id", "name", "loginName", "emailAddress", "createdAt", "updatedAt", "accessedAt"},
+	"Visitor":           {"id", "visitorId", "contactId", "externalId", "createdAt"},
+	"ExternalActivity":  {"id", "name", "contactId", "activityDate"},
+}
+
+// isSearchField reports whether field is searchable for the entity type.
+func isSearchField(entityType string, fields []string, field string) bool {
+	switch {
+	case entityType == "Contact" && strings.HasPrefix(field, "C_"):
+		return true
+	case entityType == "Account" && strings.HasPrefix(field, "M_"):
+		return true
+	}
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedSearchFields returns a sorted copy of fields for error messages.
+func sortedSearchFields(fields []string) []string {
+	sorted := append([]string{}, fields...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,118 @@
+package eloqua
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSearchComparisons(t *testing.T) {
+	since := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]SearchCondition{
+		`name='Newsletter'`:              SearchEqual("name", "Newsletter"),
+		`name!='Newsletter'`:             SearchNotEqual("name", "Newsletter"),
+		`id>'100'`:                       SearchGreaterThan("id", 100),
+		`id>='100'`:                      SearchGreaterThanOrEqual("id", int64(100)),
+		`updatedAt<'1420070400'`:         SearchLessThan("updatedAt", since),
+		`updatedAt<='1420070400'`:        SearchLessThanOrEqual("updatedAt", NewTimestamp(since)),
+		`isSubscribed='true'`:            SearchEqual("isSubscribed", true),
+		`name='O\'Brien\'s \*50\* off'`:  SearchEqual("name", "O'Brien's *50* off"),
+		`name='back\\slash'`:             SearchEqual("name", `back\slash`),
+		`name='News*letter'`:             SearchLike("name", "News*letter"),
+		`name='It\'s*'`:                  SearchLike("name", "It's*"),
+		`name='\*Sale*'`:                 SearchStartsWith("name", "*Sale"),
+		`C_EmailAddress='*@example.com'`: SearchEndsWith("C_EmailAddress", "@example.com"),
+		`name='*50\**'`:                  SearchContains("name", "50*"),
+	}
+
+	for want, condition := range tests {
+		if condition.Err() != nil {
+			t.Errorf("Search condition %s recieved error: %v", want, condition.Err())
+		}
+		if condition.String() != want {
+			t.Errorf("Search condition not as expected, Expected %s, Recieved %s", want, condition)
+		}
+	}
+}
+
+func TestSearchCombinations(t *testing.T) {
+	name := SearchStartsWith("name", "Test")
+	updated := SearchGreaterThan("updatedAt", Timestamp(1420070400))
+	created := SearchGreaterThan("createdAt", Timestamp(1420070400))
+
+	tests := map[string]SearchCondition{
+		`name='Test*' AND updatedAt>'1420070400'`:                             SearchAnd(name, updated),
+		`name='Test*' OR updatedAt>'1420070400' OR createdAt>'1420070400'`:    SearchOr(name, SearchOr(updated, created)),
+		`name='Test*' AND (updatedAt>'1420070400' OR createdAt>'1420070400')`: SearchAnd(name, SearchOr(updated, created)),
+		`(name='Test*' AND updatedAt>'1420070400') OR createdAt>'1420070400'`: SearchOr(SearchAnd(name, updated), created),
+		`updatedAt>'1420070400' OR createdAt>'1420070400'`:                    SearchAnd(SearchOr(updated, created)),
+		``: SearchAnd(),
+	}
+
+	for want, condition := range tests {
+		if condition.String() != want {
+			t.Errorf("Search condition not as expected, Expected %s, Recieved %s", want, condition)
+		}
+	}
+
+	fields := SearchAnd(name, SearchOr(updated, created)).Fields()
+	testModels(t, "SearchCondition.Fields", fields, []string{"name", "updatedAt", "createdAt"})
+}
+
+func TestSearchValidate(t *testing.T) {
+	valid := []struct {
+		entityType string
+		condition  SearchCondition
+	}{
+		{"Email", SearchAnd(SearchEqual("name", "Test"), SearchEqual("subject", "Hi"))},
+		{"Contact", SearchEqual("C_EmailAddress", "test@example.com")},
+		{"Account", SearchEqual("M_CompanyName", "Acme")},
+		{"Unknown", SearchEqual("anything", "Test")},
+	}
+	for _, test := range valid {
+		if err := test.condition.Validate(test.entityType); err != nil {
+			t.Errorf("Expected %s search to be valid for %s, Recieved: %v", test.condition, test.entityType, err)
+		}
+	}
+
+	invalid := []struct {
+		entityType string
+		condition  SearchCondition
+	}{
+		{"Email", SearchOr(SearchEqual("name", "Test"), SearchEqual("C_EmailAddress", "x"))},
+		{"Account", SearchEqual("C_EmailAddress", "test@example.com")},
+		{"Contact", SearchEqual("name", 1.5)},
+		{"Contact", SearchEqual("name='x' OR id", "1")},
+		{"Contact", SearchLike("", "x*")},
+	}
+	for _, test := range invalid {
+		if err := test.condition.Validate(test.entityType); err == nil {
+			t.Errorf("Expected %s search to be invalid for %s", test.condition, test.entityType)
+		}
+	}
+}
+
+func TestListOptionsSetSearch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/assets/emails", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "search", `name='Test*' AND updatedAt>'1420070400'`)
+		w.Write([]byte(`{"elements":[],"page":1,"pageSize":1000,"total":0}`))
+	})
+
+	opts := &ListOptions{}
+	if err := opts.SetSearch("Email", SearchAnd(SearchStartsWith("name", "Test"), SearchGreaterThan("updatedAt", Timestamp(1420070400)))); err != nil {
+		t.Fatalf("ListOptions.SetSearch recieved error: %v", err)
+	}
+	if _, _, err := client.Emails.List(opts); err != nil {
+		t.Errorf("Emails.List recieved error: %v", err)
+	}
+
+	if err := opts.SetSearch("Email", SearchEqual("C_Country", "UK")); err == nil {
+		t.Error("Expected an error setting an invalid search")
+	}
+	if opts.Search != `name='Test*' AND updatedAt>'1420070400'` {
+		t.Errorf("Search should not be changed by an invalid search, Recieved: %s", opts.Search)
+	}
+}
@@ -0,0 +1,115 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampType is used to report timestamp decoding errors.
+var timestampType = reflect.TypeOf(Timestamp(0))
+
+// Timestamp represents an Eloqua timestamp, The number of seconds since the Unix epoch.
+// Eloqua sends timestamps as strings, For example "1420070400", Which Timestamp
+// marshals to and from. A zero Timestamp is omitted from requests.
+type Timestamp int64
+
+// NewTimestamp creates a Timestamp from the given time.
+// The zero time gives a zero Timestamp.
+func NewTimestamp(t time.Time) Timestamp {
+	if t.IsZero() {
+		return 0
+	}
+	return Timestamp(t.Unix())
+}
+
+// Time returns the timestamp as a UTC time.
+// A zero Timestamp gives the zero time.
+func (t Timestamp) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t), 0).UTC()
+}
+
+// IsZero reports whether the timestamp is unset.
+func (t Timestamp) IsZero() bool {
+	return t == 0
+}
+
+// String returns the timestamp formatted as RFC 3339 in UTC.
+func (t Timestamp) String() string {
+	if t == 0 {
+		return ""
+	}
+	return t.Time().Format(time.RFC3339)
+}
+
+// BulkString returns the timestamp in the date format used by Bulk API filters.
+func (t Timestamp) BulkString() string {
+	return FormatBulkTime(t.Time())
+}
+
+// MarshalJSON encodes the timestamp as an Eloqua epoch string.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(t), 10))), nil
+}
+
+// UnmarshalJSON decodes an Eloqua epoch string or number.
+// Empty strings & null decode as a zero Timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*t = 0
+		return nil
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: "string " + string(data), Type: timestampType}
+	}
+	*t = Timestamp(n)
+	return nil
+}
+
+// EncodeValues encodes the timestamp as epoch seconds in list request query strings.
+func (t Timestamp) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, strconv.FormatInt(int64(t), 10))
+	return nil
+}
+
+// BulkTimeFormat is the date format used in Bulk API filters.
+const BulkTimeFormat = "2006-01-02T15:04:05Z"
+
+// bulkTimeLayouts are the date formats found in Bulk API responses & exported data.
+var bulkTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// FormatBulkTime formats the given time, In UTC, for use in Bulk API filters.
+func FormatBulkTime(t time.Time) string {
+	return t.UTC().Format(BulkTimeFormat)
+}
+
+// ParseBulkTime parses a date from a Bulk API response or exported record.
+// Dates without a time zone are parsed in loc, Which should be UTC when the export
+// has AreSystemTimestampsInUTC set, Otherwise the time zone of the Eloqua instance.
+// A nil loc is treated as UTC.
+func ParseBulkTime(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range bulkTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("Unrecognised Bulk API date: " + s)
+}
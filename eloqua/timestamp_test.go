@@ -0,0 +1,105 @@
+package eloqua
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	v := struct {
+		CreatedAt Timestamp `json:"createdAt,omitempty"`
+		UpdatedAt Timestamp `json:"updatedAt,omitempty"`
+	}{}
+
+	if err := json.Unmarshal([]byte(`{"createdAt":"1420070400","updatedAt":1420070460}`), &v); err != nil {
+		t.Fatalf("Timestamp unmarshal recieved error: %v", err)
+	}
+	if v.CreatedAt != 1420070400 || v.UpdatedAt != 1420070460 {
+		t.Errorf("Timestamps not decoded as expected, Recieved: %+v", v)
+	}
+
+	content, _ := json.Marshal(v)
+	if string(content) != `{"createdAt":"1420070400","updatedAt":"1420070460"}` {
+		t.Errorf("Timestamps not encoded as expected, Recieved: %s", content)
+	}
+
+	v.UpdatedAt = 0
+	content, _ = json.Marshal(v)
+	if string(content) != `{"createdAt":"1420070400"}` {
+		t.Errorf("Zero timestamp should be omitted, Recieved: %s", content)
+	}
+
+	if err := json.Unmarshal([]byte(`{"createdAt":"","updatedAt":null}`), &v); err != nil || v.CreatedAt != 0 {
+		t.Errorf("Empty timestamps should decode as zero, Recieved: %+v %v", v, err)
+	}
+	if err := json.Unmarshal([]byte(`{"createdAt":"yesterday"}`), &v); err == nil {
+		t.Error("Expected an error decoding an invalid timestamp")
+	}
+}
+
+func TestTimestampTime(t *testing.T) {
+	local := time.Date(2015, 1, 1, 5, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	ts := NewTimestamp(local)
+
+	if ts != 1420106400 {
+		t.Errorf("NewTimestamp not as expected, Recieved: %d", ts)
+	}
+	if !ts.Time().Equal(local) || ts.Time().Location() != time.UTC {
+		t.Errorf("Timestamp.Time not as expected, Recieved: %s", ts.Time())
+	}
+	if ts.String() != "2015-01-01T10:00:00Z" {
+		t.Errorf("Timestamp.String not as expected, Recieved: %s", ts)
+	}
+	if ts.BulkString() != "2015-01-01T10:00:00Z" {
+		t.Errorf("Timestamp.BulkString not as expected, Recieved: %s", ts.BulkString())
+	}
+
+	if !NewTimestamp(time.Time{}).IsZero() || !Timestamp(0).Time().IsZero() || Timestamp(0).String() != "" {
+		t.Error("Zero timestamps should convert to and from the zero time")
+	}
+}
+
+func TestTimestampListOption(t *testing.T) {
+	setup()
+	defer teardown()
+
+	addRestHandlerFunc("/data/contacts", func(w http.ResponseWriter, req *http.Request) {
+		testURLParam(t, req, "lastUpdatedAt", "1420070400")
+		w.Write([]byte(`{"elements":[],"page":1,"pageSize":1000,"total":0}`))
+	})
+
+	opts := &ListOptions{LastUpdatedAt: NewTimestamp(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))}
+	if _, _, err := client.Contacts.List(opts); err != nil {
+		t.Errorf("Contacts.List recieved error: %v", err)
+	}
+}
+
+func TestParseBulkTime(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	tests := []struct {
+		value string
+		loc   *time.Location
+		want  time.Time
+	}{
+		{"2017-08-25T19:21:07.6770000Z", nil, time.Date(2017, 8, 25, 19, 21, 7, 677000000, time.UTC)},
+		{"2019-02-04 10:14:33.453", nil, time.Date(2019, 2, 4, 10, 14, 33, 453000000, time.UTC)},
+		{"2019-02-04 10:14:33.453", est, time.Date(2019, 2, 4, 15, 14, 33, 453000000, time.UTC)},
+		{"2019-02-04 10:14:33", nil, time.Date(2019, 2, 4, 10, 14, 33, 0, time.UTC)},
+		{"2019-02-04", nil, time.Date(2019, 2, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		parsed, err := ParseBulkTime(test.value, test.loc)
+		if err != nil {
+			t.Errorf("ParseBulkTime(%s) recieved error: %v", test.value, err)
+		} else if !parsed.Equal(test.want) {
+			t.Errorf("ParseBulkTime(%s) not as expected, Expected %s, Recieved %s", test.value, test.want, parsed)
+		}
+	}
+
+	if _, err := ParseBulkTime("04/02/2019", nil); err == nil {
+		t.Error("Expected an error parsing an unrecognised date")
+	}
+}
@@ -1,6 +1,7 @@
 package eloqua
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,27 +15,27 @@ type UserService struct {
 
 // User represents an Eloqua system user.
 type User struct {
-	Type                 string   `json:"type,omitempty"`
-	AccessedAt           int      `json:"accessedAt,omitempty,string"`
-	CurrentStatus        string   `json:"currentStatus,omitempty"`
-	ID                   int      `json:"id,omitempty,string"`
-	CreatedAt            int      `json:"createdAt,omitempty,string"`
-	CreatedBy            int      `json:"createdBy,omitempty,string"`
-	Description          string   `json:"description,omitempty"`
-	Depth                string   `json:"depth,omitempty"`
-	FolderID             int      `json:"folderId,omitempty,string"`
-	Name                 string   `json:"name,omitempty"`
-	Permissions          []string `json:"permissions,omitempty"`
-	UpdatedAt            int      `json:"updatedAt,omitempty,string"`
-	UpdatedBy            int      `json:"updatedBy,omitempty,string"`
-	ScheduledFor         int      `json:"scheduledFor,omitempty,string"`
-	SourceTemplateID     string   `json:"sourceTemplateId,omitempty"`
-	BetaAccess           []string `json:"betaAccess,omitempty"`
-	Capabilities         []string `json:"capabilities,omitempty"`
-	Company              string   `json:"company,omitempty"`
-	DefaultAccountViewID int      `json:"defaultAccountViewId,omitempty,string"`
-	DefaultContactViewID int      `json:"defaultContactViewId,omitempty,string"`
-	EmailAddress         string   `json:"emailAddress,omitempty"`
+	Type                 string    `json:"type,omitempty"`
+	AccessedAt           Timestamp `json:"accessedAt,omitempty"`
+	CurrentStatus        string    `json:"currentStatus,omitempty"`
+	ID                   int       `json:"id,omitempty,string"`
+	CreatedAt            Timestamp `json:"createdAt,omitempty"`
+	CreatedBy            int       `json:"createdBy,omitempty,string"`
+	Description          string    `json:"description,omitempty"`
+	Depth                string    `json:"depth,omitempty"`
+	FolderID             int       `json:"folderId,omitempty,string"`
+	Name                 string    `json:"name,omitempty"`
+	Permissions          []string  `json:"permissions,omitempty"`
+	UpdatedAt            Timestamp `json:"updatedAt,omitempty"`
+	UpdatedBy            int       `json:"updatedBy,omitempty,string"`
+	ScheduledFor         Timestamp `json:"scheduledFor,omitempty"`
+	SourceTemplateID     string    `json:"sourceTemplateId,omitempty"`
+	BetaAccess           []string  `json:"betaAccess,omitempty"`
+	Capabilities         []string  `json:"capabilities,omitempty"`
+	Company              string    `json:"company,omitempty"`
+	DefaultAccountViewID int       `json:"defaultAccountViewId,omitempty,string"`
+	DefaultContactViewID int       `json:"defaultContactViewId,omitempty,string"`
+	EmailAddress         string    `json:"emailAddress,omitempty"`
 
 	LoggedInAt string `json:"loggedInAt,omitempty"`
 	LoginName  string `json:"loginName,omitempty"`
@@ -81,30 +82,55 @@ type ProductPermission struct {
 
 // Get an user object via its ID
 func (e *UserService) Get(id int) (*User, *Response, error) {
+	return e.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is like Get but performs the request with the given context.
+func (e *UserService) GetWithContext(ctx context.Context, id int) (*User, *Response, error) {
 	endpoint := fmt.Sprintf("/system/user/%d?depth=complete", id)
 	user := &User{}
-	resp, err := e.client.getRequestDecode(endpoint, user)
+	resp, err := e.client.getRequestDecode(ctx, endpoint, user)
 	return user, resp, err
 }
 
 // List many Eloqua users
 func (e *UserService) List(opts *ListOptions) ([]User, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *UserService) ListWithContext(ctx context.Context, opts *ListOptions) ([]User, *Response, error) {
 	endpoint := "/system/users"
 	users := new([]User)
-	resp, err := e.client.getRequestListDecode(endpoint, users, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, users, opts)
 	return *users, resp, err
 }
 
+// ListPager returns a Pager to iterate over every page of Eloqua users
+func (e *UserService) ListPager(opts *ListOptions) *Pager[User] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every Eloqua users, Requesting each page in turn
+func (e *UserService) ListAll(ctx context.Context, opts *ListOptions) ([]User, error) {
+	return e.ListPager(opts).All(ctx)
+}
+
 // Update an existing user in eloqua
 // This endpoint does not seem to be fully stable and/or working fully
 // Could not get reliably functioning during testing
 func (e *UserService) Update(id int, name string, user *User) (*User, *Response, error) {
+	return e.UpdateWithContext(context.Background(), id, name, user)
+}
+
+// UpdateWithContext is like Update but performs the request with the given context.
+func (e *UserService) UpdateWithContext(ctx context.Context, id int, name string, user *User) (*User, *Response, error) {
 	if user == nil {
 		user = &User{}
 	}
 	user.ID = id
 	user.Name = name
 	endpoint := fmt.Sprintf("/system/user/%d", user.ID)
-	resp, err := e.client.putRequestDecode(endpoint, user)
+	resp, err := e.client.putRequestDecode(ctx, endpoint, user)
 	return user, resp, err
 }
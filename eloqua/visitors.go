@@ -1,5 +1,7 @@
 package eloqua
 
+import "context"
+
 // VisitorService provides access to all the endpoints related
 // to Visitor data within eloqua
 //
@@ -10,20 +12,35 @@ type VisitorService struct {
 
 // Visitor represents an Eloqua Visitor object.
 type Visitor struct {
-	Type                 string `json:"type,omitempty"`
-	VisitorID            int    `json:"visitorId,omitempty,string"`
-	CreatedAt            int    `json:"createdAt,omitempty,string"`
-	IPAddress            string `json:"V_IPAddress,omitempty"`
-	LastVisitDateAndTime int    `json:"V_LastVisitDateAndTime,omitempty,string"`
-	ExternalID           string `json:"externalId,omitempty"`
-	ContactID            int    `json:"contactId,omitempty,string"`
-	CurrentStatus        string `json:"currentStatus,omitempty"`
+	Type                 string    `json:"type,omitempty"`
+	VisitorID            int       `json:"visitorId,omitempty,string"`
+	CreatedAt            Timestamp `json:"createdAt,omitempty"`
+	IPAddress            string    `json:"V_IPAddress,omitempty"`
+	LastVisitDateAndTime Timestamp `json:"V_LastVisitDateAndTime,omitempty"`
+	ExternalID           string    `json:"externalId,omitempty"`
+	ContactID            int       `json:"contactId,omitempty,string"`
+	CurrentStatus        string    `json:"currentStatus,omitempty"`
 }
 
 // List many eloqua visitors
 func (e *VisitorService) List(opts *ListOptions) ([]Visitor, *Response, error) {
+	return e.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but performs the request with the given context.
+func (e *VisitorService) ListWithContext(ctx context.Context, opts *ListOptions) ([]Visitor, *Response, error) {
 	endpoint := "/data/visitors"
 	visitors := new([]Visitor)
-	resp, err := e.client.getRequestListDecode(endpoint, visitors, opts)
+	resp, err := e.client.getRequestListDecode(ctx, endpoint, visitors, opts)
 	return *visitors, resp, err
 }
+
+// ListPager returns a Pager to iterate over every page of eloqua visitors
+func (e *VisitorService) ListPager(opts *ListOptions) *Pager[Visitor] {
+	return newPager(opts, e.ListWithContext)
+}
+
+// ListAll lists every eloqua visitors, Requesting each page in turn
+func (e *VisitorService) ListAll(ctx context.Context, opts *ListOptions) ([]Visitor, error) {
+	return e.ListPager(opts).All(ctx)
+}